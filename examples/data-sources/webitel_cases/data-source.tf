@@ -0,0 +1,8 @@
+data "webitel_cases" "by_reporter" {
+  reporter_id = webitel_contact.example.id
+}
+
+data "webitel_cases" "refunds" {
+  q          = "refund"
+  service_id = webitel_case_service.refunds.id
+}
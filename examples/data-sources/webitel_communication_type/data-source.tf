@@ -0,0 +1,14 @@
+data "webitel_communication_type" "mobile" {
+  code = "mobile"
+}
+
+resource "webitel_contact" "john" {
+  name = "John Doe"
+
+  phones = [
+    {
+      code        = data.webitel_communication_type.mobile.id
+      destination = "+380501234567"
+    }
+  ]
+}
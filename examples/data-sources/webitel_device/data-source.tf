@@ -0,0 +1,7 @@
+data "webitel_device" "by_account" {
+  account = "reception"
+}
+
+data "webitel_device" "by_mac" {
+  mac = "00-15-65-0a-1b-2c"
+}
@@ -0,0 +1,7 @@
+data "webitel_user" "operator" {
+  extension = "1024"
+}
+
+output "operator_id" {
+  value = data.webitel_user.operator.id
+}
@@ -0,0 +1,14 @@
+variable "team_id" {
+  type = string
+}
+
+data "webitel_wfm_forecast_calculation" "inbound" {
+  name = "Inbound calls"
+}
+
+data "webitel_wfm_forecast" "january" {
+  calculation_id = data.webitel_wfm_forecast_calculation.inbound.id
+  team_id        = var.team_id
+  from           = "1767225600000" # 2026-01-01
+  to             = "1769904000000" # 2026-02-01
+}
@@ -0,0 +1,3 @@
+data "webitel_wfm_forecast_calculation" "inbound" {
+  name = "Inbound calls"
+}
@@ -0,0 +1,2 @@
+# ACL grant can be imported using the object class, object ID and grantee ID separated by "/".
+terraform import webitel_acl_grant.supervisor_calendar calendars/5/12
@@ -0,0 +1,6 @@
+resource "webitel_acl_grant" "supervisor_calendar" {
+  object     = "calendars"
+  object_id  = "5"
+  grantee_id = webitel_role.supervisor.id
+  grants     = "rw"
+}
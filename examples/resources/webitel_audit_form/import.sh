@@ -0,0 +1,2 @@
+# Audit Form can be imported using the Audit Form ID.
+terraform import webitel_audit_form.support 1
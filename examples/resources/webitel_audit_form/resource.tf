@@ -0,0 +1,29 @@
+resource "webitel_audit_form" "support" {
+  name        = "Support scorecard"
+  description = "Quality review of the support calls"
+  team_ids    = ["12"]
+
+  questions = [
+    {
+      question = "Did the agent greet the customer?"
+      type     = "question_option"
+      required = true
+      options = [
+        {
+          name  = "Yes"
+          score = 1
+        },
+        {
+          name  = "No"
+          score = 0
+        },
+      ]
+    },
+    {
+      question = "Rate the resolution"
+      type     = "question_score"
+      min      = 0
+      max      = 10
+    },
+  ]
+}
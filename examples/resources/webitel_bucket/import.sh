@@ -0,0 +1,2 @@
+# Bucket can be imported by its ID.
+terraform import webitel_bucket.vip 12
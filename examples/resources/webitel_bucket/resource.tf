@@ -0,0 +1,4 @@
+resource "webitel_bucket" "vip" {
+  name        = "vip"
+  description = "Members with the premium support plan"
+}
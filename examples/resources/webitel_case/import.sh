@@ -0,0 +1,2 @@
+# Case can be imported using the Case ID.
+terraform import webitel_case.refund 1
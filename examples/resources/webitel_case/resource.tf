@@ -0,0 +1,20 @@
+resource "webitel_case" "refund" {
+  subject     = "Refund for order 1042"
+  description = "The customer was charged twice."
+  reporter_id = webitel_contact.example.id
+  impacted_id = webitel_contact.example.id
+  service_id  = webitel_case_service.refunds.id
+  priority_id = webitel_case_priority.high.id
+  source_id   = webitel_case_source.phone.id
+
+  related = [
+    {
+      case_id       = webitel_case.charge.id
+      relation_type = "DUPLICATES"
+    }
+  ]
+
+  comments = [
+    "Escalated to the billing team.",
+  ]
+}
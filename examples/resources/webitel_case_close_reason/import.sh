@@ -0,0 +1,2 @@
+# Case Close Reason can be imported using the Case Close Reason Group ID and the reason ID separated by "/".
+terraform import webitel_case_close_reason.solved 1/2
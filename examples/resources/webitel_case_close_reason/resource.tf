@@ -0,0 +1,14 @@
+resource "webitel_case_close_reason_group" "support" {
+  name = "Support"
+}
+
+resource "webitel_case_close_reason" "solved" {
+  close_reason_group_id = webitel_case_close_reason_group.support.id
+  name                  = "Solved"
+}
+
+resource "webitel_case_close_reason" "duplicate" {
+  close_reason_group_id = webitel_case_close_reason_group.support.id
+  name                  = "Duplicate"
+  description           = "The request is already registered"
+}
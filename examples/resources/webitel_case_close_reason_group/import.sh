@@ -0,0 +1,2 @@
+# Case Close Reason Group can be imported using the Case Close Reason Group ID.
+terraform import webitel_case_close_reason_group.support 1
@@ -0,0 +1,4 @@
+resource "webitel_case_close_reason_group" "support" {
+  name        = "Support"
+  description = "The support request close reasons"
+}
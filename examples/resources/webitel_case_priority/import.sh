@@ -0,0 +1,2 @@
+# Case Priority can be imported using the Case Priority ID.
+terraform import webitel_case_priority.high 1
@@ -0,0 +1,5 @@
+resource "webitel_case_priority" "high" {
+  name        = "High"
+  description = "Resolve within the working day"
+  color       = "#E53935"
+}
@@ -0,0 +1,2 @@
+# Case Service can be imported using the Case Service ID.
+terraform import webitel_case_service.billing 1
@@ -0,0 +1,12 @@
+resource "webitel_case_service" "billing" {
+  catalog_id = webitel_case_service_catalog.support.id
+  name       = "Billing"
+  code       = "billing"
+}
+
+resource "webitel_case_service" "refunds" {
+  catalog_id = webitel_case_service.billing.id
+  root_id    = webitel_case_service_catalog.support.id
+  name       = "Refunds"
+  sla_id     = webitel_case_sla.urgent.id
+}
@@ -0,0 +1,2 @@
+# Case Service Catalog can be imported using the Case Service Catalog ID.
+terraform import webitel_case_service_catalog.support 1
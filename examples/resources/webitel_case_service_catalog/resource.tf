@@ -0,0 +1,8 @@
+resource "webitel_case_service_catalog" "support" {
+  name                  = "Customer support"
+  prefix                = "SUP"
+  sla_id                = webitel_case_sla.standard.id
+  status_id             = webitel_case_status.support.id
+  close_reason_group_id = webitel_case_close_reason_group.support.id
+  team_ids              = ["1"]
+}
@@ -0,0 +1,2 @@
+# Case SLA can be imported using the Case SLA ID.
+terraform import webitel_case_sla.standard 1
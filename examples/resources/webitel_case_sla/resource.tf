@@ -0,0 +1,6 @@
+resource "webitel_case_sla" "standard" {
+  name            = "Standard"
+  calendar_id     = "1"
+  reaction_time   = "3600000"  # 1 hour
+  resolution_time = "86400000" # 1 day
+}
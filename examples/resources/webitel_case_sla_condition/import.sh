@@ -0,0 +1,2 @@
+# Case SLA condition can be imported using the Case SLA ID and the condition ID separated by "/".
+terraform import webitel_case_sla_condition.high 1/2
@@ -0,0 +1,18 @@
+resource "webitel_case_priority" "high" {
+  name = "High"
+}
+
+resource "webitel_case_sla" "standard" {
+  name            = "Standard"
+  calendar_id     = "1"
+  reaction_time   = "3600000"
+  resolution_time = "86400000"
+}
+
+resource "webitel_case_sla_condition" "high" {
+  sla_id          = webitel_case_sla.standard.id
+  name            = "High priority"
+  priority_ids    = [webitel_case_priority.high.id]
+  reaction_time   = "900000"   # 15 minutes
+  resolution_time = "14400000" # 4 hours
+}
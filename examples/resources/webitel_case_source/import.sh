@@ -0,0 +1,2 @@
+# Case Source can be imported using the Case Source ID.
+terraform import webitel_case_source.phone 1
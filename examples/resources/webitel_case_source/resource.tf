@@ -0,0 +1,5 @@
+resource "webitel_case_source" "phone" {
+  name        = "Phone"
+  description = "The cases registered from the calls"
+  type        = "CALL"
+}
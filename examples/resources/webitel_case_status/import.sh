@@ -0,0 +1,2 @@
+# Case Status can be imported using the Case Status ID.
+terraform import webitel_case_status.support 1
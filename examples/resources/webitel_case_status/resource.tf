@@ -0,0 +1,4 @@
+resource "webitel_case_status" "support" {
+  name        = "Support"
+  description = "The support request lifecycle"
+}
@@ -0,0 +1,2 @@
+# Case Status condition can be imported using the Case Status ID and the condition ID separated by "/".
+terraform import webitel_case_status_condition.new 1/2
@@ -0,0 +1,15 @@
+resource "webitel_case_status" "support" {
+  name = "Support"
+}
+
+resource "webitel_case_status_condition" "new" {
+  status_id = webitel_case_status.support.id
+  name      = "New"
+  initial   = true
+}
+
+resource "webitel_case_status_condition" "resolved" {
+  status_id = webitel_case_status.support.id
+  name      = "Resolved"
+  final     = true
+}
@@ -0,0 +1,2 @@
+# Chat plan can be imported using the chat plan ID.
+terraform import webitel_chat_plan.support 1
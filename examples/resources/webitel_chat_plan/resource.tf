@@ -0,0 +1,12 @@
+resource "webitel_routing_schema" "chat" {
+  name = "chat-inbound"
+  type = "chat"
+
+  schema = file("${path.module}/flows/chat-inbound.json")
+}
+
+resource "webitel_chat_plan" "support" {
+  name        = "support"
+  description = "Routes the support chats to the bot"
+  schema_id   = webitel_routing_schema.chat.id
+}
@@ -0,0 +1,2 @@
+# Cognitive Profile can be imported using the Cognitive Profile ID.
+terraform import webitel_cognitive_profile.azure_tts 1
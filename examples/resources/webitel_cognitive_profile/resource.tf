@@ -0,0 +1,17 @@
+variable "azure_speech_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "webitel_cognitive_profile" "azure_tts" {
+  name          = "Azure TTS"
+  provider_type = "Microsoft"
+  service       = "TTS"
+  default       = true
+
+  properties = jsonencode({
+    key    = var.azure_speech_key
+    region = "westeurope"
+    locale = "en-US"
+  })
+}
@@ -0,0 +1,2 @@
+# Communication Type can be imported using the Communication Type ID.
+terraform import webitel_communication_type.work 3
@@ -0,0 +1,6 @@
+resource "webitel_communication_type" "work" {
+  name        = "Work phone"
+  code        = "work"
+  channel     = "Phone"
+  description = "Office landline"
+}
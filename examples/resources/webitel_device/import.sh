@@ -0,0 +1,2 @@
+# Device can be imported using the Device ID. The password is not imported.
+terraform import webitel_device.reception 15
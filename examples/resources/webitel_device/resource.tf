@@ -0,0 +1,18 @@
+resource "webitel_device" "reception" {
+  name     = "Reception desk phone"
+  account  = "reception"
+  password = var.reception_password
+  mac      = "00:15:65:0A:1B:2C"
+  brand    = "Yealink"
+  model    = "T46U"
+  user_id  = webitel_user.jdoe.id
+
+  provision = {
+    "linekey.1.label" = "Reception"
+  }
+}
+
+variable "reception_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Email Profile can be imported using the Email Profile ID. The password and the OAuth2 secret are not imported.
+terraform import webitel_email_profile.support 2
@@ -0,0 +1,41 @@
+resource "webitel_email_profile" "support" {
+  name           = "Support mailbox"
+  enabled        = true
+  login          = "support@example.com"
+  password       = var.support_mailbox_password
+  mailbox        = "INBOX"
+  imap_host      = "imap.example.com"
+  imap_port      = 993
+  smtp_host      = "smtp.example.com"
+  smtp_port      = 587
+  fetch_interval = 60
+  schema_id      = webitel_routing_schema.email.id
+}
+
+resource "webitel_email_profile" "office365" {
+  name      = "Sales mailbox"
+  auth_type = "OAuth2"
+  login     = "sales@example.com"
+  mailbox   = "INBOX"
+  imap_host = "outlook.office365.com"
+  imap_port = 993
+  smtp_host = "smtp.office365.com"
+  smtp_port = 587
+  schema_id = webitel_routing_schema.email.id
+
+  oauth2 = {
+    client_id     = "00000000-0000-0000-0000-000000000000"
+    client_secret = var.office365_client_secret
+    redirect_url  = "https://webitel.example.com/api/email/login"
+  }
+}
+
+variable "support_mailbox_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "office365_client_secret" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# List can be imported using the List ID.
+terraform import webitel_list.dnc 7
@@ -0,0 +1,7 @@
+resource "webitel_list" "dnc" {
+  name        = "DNC"
+  description = "Do not call"
+
+  # Large sets are better kept in a file, e.g. one number per line.
+  numbers = toset(compact(split("\n", file("${path.module}/dnc.txt"))))
+}
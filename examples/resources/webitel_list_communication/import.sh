@@ -0,0 +1,2 @@
+# List communication can be imported using the List ID and the communication ID separated by "/".
+terraform import webitel_list_communication.complaint 7/1024
@@ -0,0 +1,10 @@
+resource "webitel_list" "complaints" {
+  name = "complaints"
+}
+
+resource "webitel_list_communication" "complaint" {
+  list_id     = webitel_list.complaints.id
+  number      = "380441234567"
+  description = "Complaint #1024"
+  expire_at   = "1798761600000"
+}
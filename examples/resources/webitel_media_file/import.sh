@@ -0,0 +1,2 @@
+# Media File can be imported using the Media File ID. The content is not imported.
+terraform import webitel_media_file.welcome 42
@@ -0,0 +1,9 @@
+resource "webitel_media_file" "welcome" {
+  name   = "welcome.wav"
+  source = "${path.module}/prompts/welcome.wav"
+}
+
+resource "webitel_media_file" "hold_music" {
+  name    = "hold.mp3"
+  content = filebase64("${path.module}/prompts/hold.mp3")
+}
@@ -0,0 +1,2 @@
+# Outbound Resource can be imported using the Outbound Resource ID.
+terraform import webitel_outbound_resource.trunk 12
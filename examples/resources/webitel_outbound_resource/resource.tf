@@ -0,0 +1,13 @@
+resource "webitel_outbound_resource" "trunk" {
+  name               = "trunk"
+  gateway_id         = "3"
+  limit              = 30
+  rps                = 10
+  patterns           = ["^\\+380\\d{9}$"]
+  failure_dial_delay = 5
+  error_ids          = ["503", "486"]
+
+  variables = {
+    sip_h_X-Trunk = "main"
+  }
+}
@@ -0,0 +1,2 @@
+# Outbound Resource display number can be imported using the Outbound Resource ID and the display ID separated by "/".
+terraform import webitel_outbound_resource_display.main 12/5
@@ -0,0 +1,4 @@
+resource "webitel_outbound_resource_display" "main" {
+  resource_id = webitel_outbound_resource.trunk.id
+  display     = "380441234567"
+}
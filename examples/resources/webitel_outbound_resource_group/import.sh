@@ -0,0 +1,2 @@
+# Outbound Resource Group can be imported using the Outbound Resource Group ID.
+terraform import webitel_outbound_resource_group.office 4
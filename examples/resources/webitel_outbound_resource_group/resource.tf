@@ -0,0 +1,12 @@
+resource "webitel_outbound_resource_group" "office" {
+  name                  = "office hours"
+  strategy              = "top_down"
+  communication_type_id = "1"
+
+  time = [
+    {
+      start_time_of_day = 540
+      end_time_of_day   = 1080
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Outbound Resource Group member can be imported using the Outbound Resource Group ID and the member ID separated by "/".
+terraform import webitel_outbound_resource_group_member.trunk 4/9
@@ -0,0 +1,6 @@
+resource "webitel_outbound_resource_group_member" "trunk" {
+  group_id            = webitel_outbound_resource_group.office.id
+  resource_id         = webitel_outbound_resource.trunk.id
+  reserve_resource_id = webitel_outbound_resource.backup.id
+  priority            = 10
+}
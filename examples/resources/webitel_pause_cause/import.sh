@@ -0,0 +1,2 @@
+# Pause Cause can be imported using the Pause Cause ID.
+terraform import webitel_pause_cause.lunch 4
@@ -0,0 +1,7 @@
+resource "webitel_pause_cause" "lunch" {
+  name             = "Lunch"
+  limit_min        = 30
+  allow_agent      = true
+  allow_supervisor = true
+  allow_admin      = true
+}
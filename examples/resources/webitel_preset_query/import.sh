@@ -0,0 +1,2 @@
+# Preset Query can be imported using the Preset Query ID.
+terraform import webitel_preset_query.missed_today 1
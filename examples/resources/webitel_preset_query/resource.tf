@@ -0,0 +1,10 @@
+resource "webitel_preset_query" "missed_today" {
+  name        = "Missed today"
+  description = "Missed inbound calls since midnight"
+
+  preset = jsonencode({
+    direction = "inbound"
+    answered  = false
+    createdAt = { from = "today" }
+  })
+}
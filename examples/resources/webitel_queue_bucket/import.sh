@@ -0,0 +1,2 @@
+# Queue Bucket can be imported using the Queue ID and the binding ID separated by "/".
+terraform import webitel_queue_bucket.vip 42/7
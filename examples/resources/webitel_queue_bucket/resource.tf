@@ -0,0 +1,10 @@
+resource "webitel_bucket" "vip" {
+  name = "vip"
+}
+
+resource "webitel_queue_bucket" "vip" {
+  queue_id  = "42"
+  bucket_id = webitel_bucket.vip.id
+  ratio     = 70
+  priority  = 10
+}
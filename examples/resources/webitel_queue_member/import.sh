@@ -0,0 +1,2 @@
+# Queue Member can be imported using the Queue ID and the Member ID separated by "/".
+terraform import webitel_queue_member.john 42/100500
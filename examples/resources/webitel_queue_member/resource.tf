@@ -0,0 +1,24 @@
+resource "webitel_queue_member" "john" {
+  queue_id    = "42"
+  name        = "John Doe"
+  priority    = 10
+  expire_at   = "1798761600000"
+  bucket_id   = webitel_bucket.vip.id
+  timezone_id = "1"
+
+  variables = {
+    contract = "A-1024"
+  }
+
+  communications = [
+    {
+      code        = data.webitel_communication_type.mobile.id
+      destination = "380501234567"
+    },
+    {
+      code        = data.webitel_communication_type.work.id
+      destination = "380441234567"
+      priority    = 1
+    },
+  ]
+}
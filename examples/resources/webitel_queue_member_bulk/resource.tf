@@ -0,0 +1,22 @@
+locals {
+  contacts = provider::webitel::unique_contact(csvdecode(file("${path.module}/contacts.csv")), {
+    name_field        = "name"
+    code_field        = "code"
+    destination_field = "phone"
+    label_fields      = []
+    variable_fields   = ["contract"]
+    group_by_fields   = ["contract"]
+  })
+}
+
+resource "webitel_queue_member_bulk" "campaign" {
+  queue_id = "42"
+
+  members = {
+    for key, contact in local.contacts : key => {
+      name           = contact.name
+      variables      = contact.variables
+      communications = contact.destinations
+    }
+  }
+}
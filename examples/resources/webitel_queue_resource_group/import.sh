@@ -0,0 +1,2 @@
+# Queue Resource Group can be imported using the Queue ID and the binding ID separated by "/".
+terraform import webitel_queue_resource_group.office 42/3
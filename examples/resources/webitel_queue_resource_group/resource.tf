@@ -0,0 +1,4 @@
+resource "webitel_queue_resource_group" "office" {
+  queue_id          = "42"
+  resource_group_id = webitel_outbound_resource_group.office.id
+}
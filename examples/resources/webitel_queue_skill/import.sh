@@ -0,0 +1,2 @@
+# Queue Skill can be imported using the Queue ID and the binding ID separated by "/".
+terraform import webitel_queue_skill.english 42/15
@@ -0,0 +1,12 @@
+resource "webitel_bucket" "vip" {
+  name = "vip"
+}
+
+resource "webitel_queue_skill" "english" {
+  queue_id     = "42"
+  skill_id     = "3"
+  bucket_ids   = [webitel_bucket.vip.id]
+  lvl          = 1
+  min_capacity = 50
+  max_capacity = 100
+}
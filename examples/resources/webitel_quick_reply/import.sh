@@ -0,0 +1,2 @@
+# Quick Reply can be imported using the Quick Reply ID.
+terraform import webitel_quick_reply.greeting 1
@@ -0,0 +1,11 @@
+resource "webitel_quick_reply" "greeting" {
+  name = "Greeting"
+  text = "Hello! Thank you for contacting us. How can I help you today?"
+}
+
+resource "webitel_quick_reply" "refund_status" {
+  name     = "Refund status"
+  text     = "Refunds are processed within 5 business days."
+  team_id  = "12"
+  queue_id = "34"
+}
@@ -0,0 +1,2 @@
+# Region can be imported using the Region ID.
+terraform import webitel_region.kyiv 3
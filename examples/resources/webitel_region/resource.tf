@@ -0,0 +1,5 @@
+resource "webitel_region" "kyiv" {
+  name        = "Kyiv"
+  timezone_id = "385"
+  description = "Central region"
+}
@@ -0,0 +1,2 @@
+# Role can be imported using the Role ID.
+terraform import webitel_role.supervisor 12
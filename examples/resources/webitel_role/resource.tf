@@ -0,0 +1,14 @@
+resource "webitel_role" "supervisor" {
+  name        = "Supervisor"
+  description = "Call center supervisors"
+  permissions = ["read", "write", "add", "playback_record_file"]
+}
+
+# The permissions apply to all the objects. The access to a specific object
+# is granted to the Role separately.
+resource "webitel_acl_grant" "supervisor_calendar" {
+  object     = "calendars"
+  object_id  = "5"
+  grantee_id = webitel_role.supervisor.id
+  grants     = "r"
+}
@@ -0,0 +1,2 @@
+# Outbound call Routing rule can be imported by its ID. The position is not imported.
+terraform import webitel_routing_outbound_call.ukraine 5
@@ -0,0 +1,21 @@
+resource "webitel_routing_schema" "pstn" {
+  name = "pstn-outbound"
+  type = "voice"
+
+  schema = file("${path.module}/flows/pstn-outbound.json")
+}
+
+resource "webitel_routing_outbound_call" "emergency" {
+  name      = "emergency"
+  pattern   = "^(112|911)$"
+  schema_id = webitel_routing_schema.pstn.id
+  position  = 1
+}
+
+resource "webitel_routing_outbound_call" "ukraine" {
+  name        = "ukraine"
+  description = "National calls through the local carrier"
+  pattern     = "^\\+?380\\d{9}$"
+  schema_id   = webitel_routing_schema.pstn.id
+  position    = 2
+}
@@ -0,0 +1,2 @@
+# Routing Schema can be imported by its ID.
+terraform import webitel_routing_schema.ivr 10
@@ -0,0 +1,32 @@
+resource "webitel_routing_schema" "ivr" {
+  name        = "main-ivr"
+  type        = "voice"
+  description = "Main inbound IVR"
+  tags        = ["ivr", "inbound"]
+
+  # Schema exported from the flow designer and kept next to the configuration.
+  schema = file("${path.module}/flows/main-ivr.json")
+}
+
+resource "webitel_routing_schema" "inline" {
+  name = "hangup"
+  type = "voice"
+
+  schema = jsonencode([
+    {
+      answer = ""
+    },
+    {
+      playback = {
+        files = [
+          {
+            name = "goodbye.wav"
+          }
+        ]
+      }
+    },
+    {
+      hangup = ""
+    }
+  ])
+}
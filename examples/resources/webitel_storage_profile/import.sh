@@ -0,0 +1,2 @@
+# Storage Profile can be imported using the Storage Profile ID. The secrets are imported as returned by the API.
+terraform import webitel_storage_profile.recordings 1
@@ -0,0 +1,2 @@
+# System Setting can be imported using the System Setting name or ID.
+terraform import webitel_system_setting.amd_cancel_not_human amd_cancel_not_human
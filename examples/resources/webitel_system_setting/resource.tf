@@ -0,0 +1,22 @@
+resource "webitel_system_setting" "amd_cancel_not_human" {
+  name       = "amd_cancel_not_human"
+  bool_value = true
+}
+
+resource "webitel_system_setting" "member_chunk_size" {
+  name      = "member_chunk_size"
+  int_value = 500
+}
+
+resource "webitel_system_setting" "password_reg_exp" {
+  name         = "password_reg_exp"
+  string_value = "^(?=.*\\d)(?=.*[A-Z]).{8,}$"
+}
+
+resource "webitel_system_setting" "export_settings" {
+  name = "export_settings"
+  json_value = jsonencode({
+    format    = "csv"
+    separator = ";"
+  })
+}
@@ -0,0 +1,2 @@
+# Trigger can be imported using the Trigger ID.
+terraform import webitel_trigger.nightly_cleanup 1
@@ -0,0 +1,13 @@
+resource "webitel_trigger" "nightly_cleanup" {
+  name        = "Nightly cleanup"
+  description = "Closes the stale cases every night"
+  expression  = "0 2 * * *"
+  timezone_id = "385"
+  schema_id   = webitel_routing_schema.cleanup.id
+  timeout     = 300
+  enabled     = true
+
+  variables = {
+    stale_days = "30"
+  }
+}
@@ -0,0 +1,2 @@
+# User can be imported using the User ID. The password is not imported.
+terraform import webitel_user.jdoe 64
@@ -0,0 +1,20 @@
+resource "webitel_user" "jdoe" {
+  username  = "jdoe"
+  password  = var.jdoe_password
+  name      = "John Doe"
+  extension = "1024"
+  email     = "jdoe@example.com"
+  chat_name = "John"
+  roles     = ["2"]
+  devices   = ["15"]
+  licenses  = ["CALL_CENTER"]
+
+  profile = {
+    team = "support"
+  }
+}
+
+variable "jdoe_password" {
+  type      = string
+  sensitive = true
+}
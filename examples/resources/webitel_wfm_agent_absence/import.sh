@@ -0,0 +1,2 @@
+# Agent Absence can be imported using the Agent ID and the Absence ID separated by "/".
+terraform import webitel_wfm_agent_absence.vacation 1/2
@@ -0,0 +1,9 @@
+variable "agent_id" {
+  type = string
+}
+
+resource "webitel_wfm_agent_absence" "vacation" {
+  agent_id  = var.agent_id
+  absent_at = "1767225600000" # 2026-01-01
+  type      = "AGENT_ABSENCE_TYPE_VACATION"
+}
@@ -0,0 +1,2 @@
+# Pause Template can be imported using the Pause Template ID.
+terraform import webitel_wfm_pause_template.default 1
@@ -0,0 +1,16 @@
+resource "webitel_pause_cause" "lunch" {
+  name        = "Lunch"
+  limit_min   = 60
+  allow_agent = true
+}
+
+resource "webitel_wfm_pause_template" "default" {
+  name = "Default pauses"
+
+  causes = [
+    {
+      cause_id = webitel_pause_cause.lunch.id
+      duration = 60
+    }
+  ]
+}
@@ -0,0 +1,2 @@
+# Shift Template can be imported using the Shift Template ID.
+terraform import webitel_wfm_shift_template.day 1
@@ -0,0 +1,15 @@
+resource "webitel_wfm_shift_template" "day" {
+  name        = "Day shift"
+  description = "Two working ranges split by the lunch break"
+
+  times = [
+    {
+      start = 540 # 09:00
+      end   = 780 # 13:00
+    },
+    {
+      start = 840  # 14:00
+      end   = 1080 # 18:00
+    }
+  ]
+}
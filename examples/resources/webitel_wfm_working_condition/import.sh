@@ -0,0 +1,2 @@
+# Working Condition can be imported using the Working Condition ID.
+terraform import webitel_wfm_working_condition.full_time 1
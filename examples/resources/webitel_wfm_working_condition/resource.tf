@@ -0,0 +1,39 @@
+resource "webitel_wfm_shift_template" "day" {
+  name = "Day shift"
+
+  times = [
+    {
+      start = 540  # 09:00
+      end   = 1080 # 18:00
+    }
+  ]
+}
+
+resource "webitel_pause_cause" "lunch" {
+  name        = "Lunch"
+  limit_min   = 60
+  allow_agent = true
+}
+
+resource "webitel_wfm_pause_template" "default" {
+  name = "Default pauses"
+
+  causes = [
+    {
+      cause_id = webitel_pause_cause.lunch.id
+      duration = 60
+    }
+  ]
+}
+
+resource "webitel_wfm_working_condition" "full_time" {
+  name               = "Full time"
+  workday_hours      = 8
+  workdays_per_month = 21
+  vacation           = 24
+  sick_leaves        = 10
+  days_off           = 8
+  pause_duration     = 60
+  pause_template_id  = webitel_wfm_pause_template.default.id
+  shift_template_id  = webitel_wfm_shift_template.day.id
+}
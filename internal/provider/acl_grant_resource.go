@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/access_store"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ACLGrantResource{}
+var _ resource.ResourceWithImportState = &ACLGrantResource{}
+
+// aclPrivileges lists the object privileges in the order the API reports them:
+// create (add) [x], [r]ead, [w]rite (modify) and [d]elete.
+const aclPrivileges = "xrwd"
+
+type ACLGrantResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Object    types.String `tfsdk:"object"`
+	ObjectID  types.String `tfsdk:"object_id"`
+	GranteeID types.String `tfsdk:"grantee_id"`
+	Grants    types.String `tfsdk:"grants"`
+}
+
+// ACLGrantResource defines the resource implementation.
+type ACLGrantResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewACLGrantResource() resource.Resource {
+	return &ACLGrantResource{}
+}
+
+func (r *ACLGrantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_grant"
+}
+
+func (r *ACLGrantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grants the Role or User the access rights on the specific object, e.g. Queue, Calendar or List.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The grant identifier in the `object/object_id/grantee_id` format.",
+			},
+			"object": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The object class name as used by the access control API, e.g. `calendars`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z_]+$`), "must be the object class name"),
+				},
+			},
+			"object_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The ID of the object to grant the access to.",
+			},
+			"grantee_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The ID of the Role or User receiving the access.",
+			},
+			"grants": schema.StringAttribute{
+				Required: true,
+				Description: "The granted privileges in the `xrwd` order: `x` create (add), `r` read, `w` write (modify) " +
+					"and `d` delete, e.g. `r` or `rwd`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^x?r?w?d?$`), "must be a subset of xrwd in that order"),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *ACLGrantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ACLGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data ACLGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.toggle(ctx, &data, data.Grants.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	data.ID = types.StringValue(aclGrantID(&data))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACLGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data ACLGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	size := int32(100)
+	params := &access_store.AccessStoreListObjectAccessParams{
+		Context:    ctx,
+		ObjectName: data.Object.ValueString(),
+		ObjectID:   data.ObjectID.ValueString(),
+		Grantee:    []string{data.GranteeID.ValueString()},
+		Size:       &size,
+	}
+
+	httpResp, err := r.client.AccessStore.AccessStoreListObjectAccess(params)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	var grants string
+	for _, v := range httpResp.GetPayload().Items {
+		if v.Grantee != nil && v.Grantee.ID == data.GranteeID.ValueString() {
+			grants = aclGrantsFromRule(v.Granted)
+
+			break
+		}
+	}
+
+	// The rule without any privileges left is revoked
+	if grants == "" {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	data.ID = types.StringValue(aclGrantID(&data))
+	data.Grants = types.StringValue(grants)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACLGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state ACLGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Revoke the privileges dropped from the configuration first, as the
+	// toggle only adds the ones listed
+	revoke := aclGrantsDiff(state.Grants.ValueString(), plan.Grants.ValueString())
+	if revoke != "" {
+		if err := r.toggle(ctx, &plan, "-"+revoke); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				"An unexpected error occurred while attempting to update the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
+	if err := r.toggle(ctx, &plan, plan.Grants.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	plan.ID = types.StringValue(aclGrantID(&plan))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ACLGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data ACLGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Ignore HTTP 404 Not Found status as the object is already gone
+	err := r.toggle(ctx, &data, "-"+aclPrivileges)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *ACLGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "object", "object_id", "grantee_id")
+}
+
+// toggle patches the object access rule of the grantee. The grants prefixed
+// with "-" are revoked, otherwise granted.
+func (r *ACLGrantResource) toggle(ctx context.Context, data *ACLGrantResourceModel, grants string) error {
+	params := &access_store.AccessStoreToggleObjectAccessParams{
+		Context:    ctx,
+		ObjectName: data.Object.ValueString(),
+		ObjectID:   data.ObjectID.ValueString(),
+		List: []*models.APIGrantAccessRequestV1{
+			{Grantee: data.GranteeID.ValueString(), Grants: grants},
+		},
+	}
+
+	httpResp, err := r.client.AccessStore.AccessStoreToggleObjectAccess(params)
+	if err != nil {
+		return err
+	}
+
+	if !httpResp.IsCode(http.StatusOK) {
+		return fmt.Errorf("unexpected HTTP status %d", httpResp.Code())
+	}
+
+	return nil
+}
+
+func aclGrantID(data *ACLGrantResourceModel) string {
+	return strings.Join([]string{data.Object.ValueString(), data.ObjectID.ValueString(), data.GranteeID.ValueString()}, "/")
+}
+
+// aclGrantsFromRule returns the privileges of the granted rule, e.g. "xrw" for
+// "----xrw-". The leading half of the full form holds the grant options.
+func aclGrantsFromRule(granted string) string {
+	if len(granted) == 2*len(aclPrivileges) {
+		granted = granted[len(aclPrivileges):]
+	}
+
+	var out strings.Builder
+	for _, c := range aclPrivileges {
+		if strings.ContainsRune(strings.ToLower(granted), c) {
+			out.WriteRune(c)
+		}
+	}
+
+	return out.String()
+}
+
+// aclGrantsDiff returns the privileges of current missing from desired.
+func aclGrantsDiff(current, desired string) string {
+	var out strings.Builder
+	for _, c := range current {
+		if !strings.ContainsRune(desired, c) {
+			out.WriteRune(c)
+		}
+	}
+
+	return out.String()
+}
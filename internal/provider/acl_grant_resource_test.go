@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestACLGrantsFromRule(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		granted  string
+		expected string
+	}{
+		"empty":       {granted: "", expected: ""},
+		"short":       {granted: "rw", expected: "rw"},
+		"full":        {granted: "-----rw-", expected: "rw"},
+		"full-create": {granted: "----xrw-", expected: "xrw"},
+		"grantable":   {granted: "xrwdxrwd", expected: "xrwd"},
+		"grant-only":  {granted: "-r------", expected: ""},
+		"placeholder": {granted: "-r-d", expected: "rd"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := aclGrantsFromRule(testCase.granted); got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,587 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/audit_form_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AuditFormResource{}
+var _ resource.ResourceWithImportState = &AuditFormResource{}
+var _ resource.ResourceWithValidateConfig = &AuditFormResource{}
+
+var auditQuestionTypes = []string{
+	string(models.EngineAuditQuestionTypeQuestionScore),
+	string(models.EngineAuditQuestionTypeQuestionOption),
+}
+
+type AuditFormResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	TeamIDs     types.Set    `tfsdk:"team_ids"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Editable    types.Bool   `tfsdk:"editable"`
+	Questions   types.List   `tfsdk:"questions"`
+}
+
+type AuditFormQuestion struct {
+	Question types.String `tfsdk:"question"`
+	Type     types.String `tfsdk:"type"`
+	Required types.Bool   `tfsdk:"required"`
+	Min      types.Int64  `tfsdk:"min"`
+	Max      types.Int64  `tfsdk:"max"`
+	Options  types.List   `tfsdk:"options"`
+}
+
+type AuditFormQuestionOption struct {
+	Name  types.String  `tfsdk:"name"`
+	Score types.Float64 `tfsdk:"score"`
+}
+
+// AuditFormResource defines the resource implementation.
+type AuditFormResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewAuditFormResource() resource.Resource {
+	return &AuditFormResource{}
+}
+
+func (r *AuditFormResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_form"
+}
+
+func auditQuestionOptionSchema() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  types.StringType,
+			"score": types.Float64Type,
+		},
+	}
+}
+
+func auditQuestionSchema() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"question": types.StringType,
+			"type":     types.StringType,
+			"required": types.BoolType,
+			"min":      types.Int64Type,
+			"max":      types.Int64Type,
+			"options":  types.ListType{ElemType: auditQuestionOptionSchema()},
+		},
+	}
+}
+
+func (r *AuditFormResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Audit Form resource. The scorecard the supervisors use to rate the agents' calls and chats.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Audit Form. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Audit Form name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Audit Form.",
+			},
+			"team_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The IDs of the teams whose agents are rated with the Audit Form.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the Audit Form is available for rating. Defaults to `true`.",
+			},
+			"editable": schema.BoolAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "Whether the questions can be changed. Webitel locks the questions once the Audit Form is used for rating.",
+			},
+			"questions": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The questions in the order they are shown to the supervisor.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"question": schema.StringAttribute{
+							Required:    true,
+							Description: "The question text.",
+						},
+						"type": schema.StringAttribute{
+							Required: true,
+							Description: "The question type. One of `question_score`, rated within the `min` and `max` range, " +
+								"or `question_option`, answered with one of the `options`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(auditQuestionTypes...),
+							},
+						},
+						"required": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+							Description: "Whether the question must be answered. Defaults to `false`.",
+						},
+						"min": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The lowest score of the `question_score` question.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"max": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The highest score of the `question_score` question.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"options": schema.ListNestedAttribute{
+							Optional:    true,
+							Description: "The answers of the `question_option` question.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "The answer text.",
+									},
+									"score": schema.Float64Attribute{
+										Required:    true,
+										Description: "The score the answer adds to the rating.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AuditFormResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AuditFormResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Questions.IsNull() || data.Questions.IsUnknown() {
+		return
+	}
+
+	for i, v := range data.Questions.Elements() {
+		obj, ok := v.(types.Object)
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+
+		var question AuditFormQuestion
+		resp.Diagnostics.Append(obj.As(ctx, &question, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(validateAuditQuestion(path.Root("questions").AtListIndex(i), question)...)
+	}
+}
+
+func (r *AuditFormResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AuditFormResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data AuditFormResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teams, diags := lookups(ctx, data.TeamIDs)
+	resp.Diagnostics.Append(diags...)
+	questions, diags := auditFormQuestions(ctx, data.Questions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreateAuditFormRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Enabled:     data.Enabled.ValueBool(),
+		Teams:       teams,
+		Questions:   questions,
+	}
+
+	httpResp, err := r.client.AuditFormService.CreateAuditFormWithParams(&audit_form_service.CreateAuditFormParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, auditFormToTF(httpResp.GetPayload()))...)
+}
+
+func (r *AuditFormResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data AuditFormResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(data.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &audit_form_service.ReadAuditFormParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	httpResp, err := r.client.AuditFormService.ReadAuditFormWithParams(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, auditFormToTF(httpResp.GetPayload()))...)
+}
+
+func (r *AuditFormResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state AuditFormResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	teams, diags := lookups(ctx, plan.TeamIDs)
+	resp.Diagnostics.Append(diags...)
+	questions, diags := auditFormQuestions(ctx, plan.Questions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &audit_form_service.UpdateAuditFormParams{
+		Context: ctx,
+		ID:      id,
+		Body: &models.EngineUpdateAuditFormRequest{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+			Enabled:     plan.Enabled.ValueBool(),
+			Teams:       teams,
+			Questions:   questions,
+		},
+	}
+
+	httpResp, err := r.client.AuditFormService.UpdateAuditFormWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, auditFormToTF(httpResp.GetPayload()))...)
+}
+
+func (r *AuditFormResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data AuditFormResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(data.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &audit_form_service.DeleteAuditFormParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.AuditFormService.DeleteAuditFormWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *AuditFormResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// validateAuditQuestion checks the question has the range or the options
+// required by its type.
+func validateAuditQuestion(p path.Path, q AuditFormQuestion) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if q.Type.IsNull() || q.Type.IsUnknown() {
+		return diags
+	}
+
+	scoreRange := []struct {
+		name  string
+		value types.Int64
+	}{{"min", q.Min}, {"max", q.Max}}
+
+	switch q.Type.ValueString() {
+	case string(models.EngineAuditQuestionTypeQuestionScore):
+		for _, v := range scoreRange {
+			if v.value.IsNull() {
+				diags.AddAttributeError(
+					p.AtName(v.name),
+					"Missing Score Range",
+					fmt.Sprintf("The %s is required for the question_score question.", v.name),
+				)
+			}
+		}
+
+		if !q.Options.IsNull() && !q.Options.IsUnknown() {
+			diags.AddAttributeError(
+				p.AtName("options"),
+				"Unexpected Question Options",
+				"The options are only supported by the question_option question.",
+			)
+		}
+
+		if !q.Min.IsNull() && !q.Min.IsUnknown() && !q.Max.IsNull() && !q.Max.IsUnknown() &&
+			q.Min.ValueInt64() >= q.Max.ValueInt64() {
+			diags.AddAttributeError(
+				p.AtName("max"),
+				"Invalid Score Range",
+				fmt.Sprintf("The max must be greater than the min, got: %d and %d.", q.Max.ValueInt64(), q.Min.ValueInt64()),
+			)
+		}
+	case string(models.EngineAuditQuestionTypeQuestionOption):
+		if q.Options.IsNull() {
+			diags.AddAttributeError(
+				p.AtName("options"),
+				"Missing Question Options",
+				"The options are required for the question_option question.",
+			)
+		}
+
+		for _, v := range scoreRange {
+			if !v.value.IsNull() && !v.value.IsUnknown() {
+				diags.AddAttributeError(
+					p.AtName(v.name),
+					"Unexpected Score Range",
+					fmt.Sprintf("The %s is only supported by the question_score question.", v.name),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+func auditFormQuestions(ctx context.Context, l types.List) ([]*models.EngineQuestion, diag.Diagnostics) {
+	var questions []AuditFormQuestion
+	diags := l.ElementsAs(ctx, &questions, false)
+
+	out := make([]*models.EngineQuestion, 0, len(questions))
+	for _, v := range questions {
+		var options []AuditFormQuestionOption
+		if !v.Options.IsNull() && !v.Options.IsUnknown() {
+			diags.Append(v.Options.ElementsAs(ctx, &options, false)...)
+		}
+
+		question := &models.EngineQuestion{
+			Question: v.Question.ValueString(),
+			Type:     models.EngineAuditQuestionType(v.Type.ValueString()).Pointer(),
+			Required: v.Required.ValueBool(),
+			Min:      int32(v.Min.ValueInt64()),
+			Max:      int32(v.Max.ValueInt64()),
+			Options:  make([]*models.QuestionOption, 0, len(options)),
+		}
+
+		for _, o := range options {
+			question.Options = append(question.Options, &models.QuestionOption{
+				Name:  o.Name.ValueString(),
+				Score: float32(o.Score.ValueFloat64()),
+			})
+		}
+
+		out = append(out, question)
+	}
+
+	return out, diags
+}
+
+// auditScoreToTF converts the score keeping its shortest decimal form, so
+// that e.g. 0.1 is not refreshed as 0.10000000149011612.
+func auditScoreToTF(in float32) types.Float64 {
+	v, _ := strconv.ParseFloat(strconv.FormatFloat(float64(in), 'g', -1, 32), 64)
+
+	return types.Float64Value(v)
+}
+
+func auditFormToTF(in *models.EngineAuditForm) *AuditFormResourceModel {
+	out := &AuditFormResourceModel{
+		ID:          types.StringValue(strconv.FormatInt(int64(in.ID), 10)),
+		Name:        types.StringValue(in.Name),
+		Description: stringOrNull(in.Description),
+		TeamIDs:     lookupsToTF(in.Teams),
+		Enabled:     types.BoolValue(in.Enabled),
+		Editable:    types.BoolValue(in.Editable),
+	}
+
+	questions := make([]attr.Value, 0, len(in.Questions))
+	for _, v := range in.Questions {
+		question := map[string]attr.Value{
+			"question": types.StringValue(v.Question),
+			"type":     types.StringNull(),
+			"required": types.BoolValue(v.Required),
+			"min":      types.Int64Null(),
+			"max":      types.Int64Null(),
+			"options":  types.ListNull(auditQuestionOptionSchema()),
+		}
+
+		if v.Type != nil {
+			question["type"] = types.StringValue(string(*v.Type))
+		}
+
+		// The range is omitted when it is zero, so it is set by the type
+		if v.Type != nil && *v.Type == models.EngineAuditQuestionTypeQuestionScore {
+			question["min"] = types.Int64Value(int64(v.Min))
+			question["max"] = types.Int64Value(int64(v.Max))
+		}
+
+		if len(v.Options) != 0 {
+			options := make([]attr.Value, 0, len(v.Options))
+			for _, o := range v.Options {
+				options = append(options, types.ObjectValueMust(auditQuestionOptionSchema().AttrTypes, map[string]attr.Value{
+					"name":  types.StringValue(o.Name),
+					"score": auditScoreToTF(o.Score),
+				}))
+			}
+
+			question["options"] = types.ListValueMust(auditQuestionOptionSchema(), options)
+		}
+
+		questions = append(questions, types.ObjectValueMust(auditQuestionSchema().AttrTypes, question))
+	}
+
+	out.Questions = types.ListValueMust(auditQuestionSchema(), questions)
+
+	return out
+}
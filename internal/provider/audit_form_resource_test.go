@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateAuditQuestion(t *testing.T) {
+	t.Parallel()
+
+	options := types.ListValueMust(auditQuestionOptionSchema(), []attr.Value{
+		types.ObjectValueMust(auditQuestionOptionSchema().AttrTypes, map[string]attr.Value{
+			"name":  types.StringValue("Yes"),
+			"score": types.Float64Value(1),
+		}),
+	})
+
+	testCases := map[string]struct {
+		question AuditFormQuestion
+		expected []string
+	}{
+		"score": {
+			question: AuditFormQuestion{
+				Type:    types.StringValue("question_score"),
+				Min:     types.Int64Value(0),
+				Max:     types.Int64Value(10),
+				Options: types.ListNull(auditQuestionOptionSchema()),
+			},
+		},
+		"score-missing-range": {
+			question: AuditFormQuestion{
+				Type:    types.StringValue("question_score"),
+				Min:     types.Int64Null(),
+				Max:     types.Int64Null(),
+				Options: types.ListNull(auditQuestionOptionSchema()),
+			},
+			expected: []string{"Missing Score Range", "Missing Score Range"},
+		},
+		"score-inverted-range": {
+			question: AuditFormQuestion{
+				Type:    types.StringValue("question_score"),
+				Min:     types.Int64Value(5),
+				Max:     types.Int64Value(5),
+				Options: types.ListNull(auditQuestionOptionSchema()),
+			},
+			expected: []string{"Invalid Score Range"},
+		},
+		"score-with-options": {
+			question: AuditFormQuestion{
+				Type:    types.StringValue("question_score"),
+				Min:     types.Int64Value(1),
+				Max:     types.Int64Unknown(),
+				Options: options,
+			},
+			expected: []string{"Unexpected Question Options"},
+		},
+		"option": {
+			question: AuditFormQuestion{
+				Type:    types.StringValue("question_option"),
+				Min:     types.Int64Null(),
+				Max:     types.Int64Null(),
+				Options: options,
+			},
+		},
+		"option-missing-options": {
+			question: AuditFormQuestion{
+				Type:    types.StringValue("question_option"),
+				Min:     types.Int64Null(),
+				Max:     types.Int64Value(3),
+				Options: types.ListNull(auditQuestionOptionSchema()),
+			},
+			expected: []string{"Missing Question Options", "Unexpected Score Range"},
+		},
+		"unknown-type": {
+			question: AuditFormQuestion{
+				Type:    types.StringUnknown(),
+				Min:     types.Int64Null(),
+				Max:     types.Int64Null(),
+				Options: types.ListNull(auditQuestionOptionSchema()),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateAuditQuestion(path.Root("questions").AtListIndex(0), testCase.question)
+			if len(diags) != len(testCase.expected) {
+				t.Fatalf("expected %d diagnostics, got: %v", len(testCase.expected), diags)
+			}
+
+			for i, d := range diags {
+				if d.Summary() != testCase.expected[i] {
+					t.Errorf("expected %q, got %q", testCase.expected[i], d.Summary())
+				}
+			}
+		})
+	}
+}
+
+func TestAuditScoreToTF(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range []float64{0.1, 2.5, -1, 100} {
+		if got := auditScoreToTF(float32(v)); got.ValueFloat64() != v {
+			t.Errorf("expected %v, got %v", v, got.ValueFloat64())
+		}
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/bucket_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BucketResource{}
+var _ resource.ResourceWithImportState = &BucketResource{}
+
+type BucketResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// BucketResource defines the resource implementation.
+type BucketResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewBucketResource() resource.Resource {
+	return &BucketResource{}
+}
+
+func (r *BucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bucket"
+}
+
+func (r *BucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Bucket resource. Buckets split queue members into groups " +
+			"that are distributed according to the queue bucket ratio and priority.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Bucket. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Bucket name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Bucket.",
+			},
+		},
+	}
+}
+
+func (r *BucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data BucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreateBucketRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}
+
+	httpResp, err := r.client.BucketService.CreateBucketWithParams(&bucket_service.CreateBucketParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, bucketToTF(httpResp.GetPayload()))...)
+}
+
+func (r *BucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data BucketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &bucket_service.ReadBucketParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.BucketService.ReadBucket(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, bucketToTF(httpResp.GetPayload()))...)
+}
+
+func (r *BucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state BucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &bucket_service.UpdateBucketParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Body: &models.EngineUpdateBucketRequest{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.BucketService.UpdateBucketWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, bucketToTF(httpResp.GetPayload()))...)
+}
+
+func (r *BucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data BucketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &bucket_service.DeleteBucketParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.BucketService.DeleteBucket(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *BucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func bucketToTF(in *models.EngineBucket) *BucketResourceModel {
+	out := &BucketResourceModel{
+		ID:          types.StringValue(in.ID),
+		Name:        types.StringValue(in.Name),
+		Description: types.StringNull(),
+	}
+
+	if in.Description != "" {
+		out.Description = types.StringValue(in.Description)
+	}
+
+	return out
+}
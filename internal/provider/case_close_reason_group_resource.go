@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/close_reason_groups"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// caseCloseReasonGroupFields are the Case Close Reason Group fields returned by the API.
+var caseCloseReasonGroupFields = []string{"id", "name", "description"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaseCloseReasonGroupResource{}
+var _ resource.ResourceWithImportState = &CaseCloseReasonGroupResource{}
+
+type CaseCloseReasonGroupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// CaseCloseReasonGroupResource defines the resource implementation.
+type CaseCloseReasonGroupResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCaseCloseReasonGroupResource() resource.Resource {
+	return &CaseCloseReasonGroupResource{}
+}
+
+func (r *CaseCloseReasonGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_close_reason_group"
+}
+
+func (r *CaseCloseReasonGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Case Close Reason Group dictionary resource. Groups the reasons the Cases of the Service Catalog are closed with.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Case Close Reason Group. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Case Close Reason Group name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Case Close Reason Group.",
+			},
+		},
+	}
+}
+
+func (r *CaseCloseReasonGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaseCloseReasonGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CaseCloseReasonGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.CasesCreateCloseReasonGroupRequest{
+		Name:        data.Name.ValueStringPointer(),
+		Description: data.Description.ValueString(),
+	}
+
+	httpResp, err := r.client.CloseReasonGroups.CreateCloseReasonGroupWithParams(&close_reason_groups.CreateCloseReasonGroupParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseCloseReasonGroupToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CaseCloseReasonGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseCloseReasonGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &close_reason_groups.LocateCloseReasonGroupParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+		Fields:  caseCloseReasonGroupFields,
+	}
+
+	httpResp, err := r.client.CloseReasonGroups.LocateCloseReasonGroup(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	group := httpResp.GetPayload().CloseReasonGroup
+	if group == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseCloseReasonGroupToTF(group))...)
+}
+
+func (r *CaseCloseReasonGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CaseCloseReasonGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &close_reason_groups.UpdateCloseReasonGroupParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Input: &models.CasesInputCloseReasonGroup{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.CloseReasonGroups.UpdateCloseReasonGroupWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseCloseReasonGroupToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CaseCloseReasonGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseCloseReasonGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &close_reason_groups.DeleteCloseReasonGroupParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.CloseReasonGroups.DeleteCloseReasonGroupWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CaseCloseReasonGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func caseCloseReasonGroupToTF(in *models.CasesCloseReasonGroup) *CaseCloseReasonGroupResourceModel {
+	return &CaseCloseReasonGroupResourceModel{
+		ID:          types.StringValue(in.ID),
+		Name:        types.StringValue(in.Name),
+		Description: stringOrNull(in.Description),
+	}
+}
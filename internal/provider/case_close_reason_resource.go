@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/close_reasons"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// caseCloseReasonFields are the Case Close Reason fields returned by the API.
+var caseCloseReasonFields = []string{"id", "name", "description", "close_reason_group_id"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaseCloseReasonResource{}
+var _ resource.ResourceWithImportState = &CaseCloseReasonResource{}
+
+type CaseCloseReasonResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	CloseReasonGroupID types.String `tfsdk:"close_reason_group_id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+}
+
+// CaseCloseReasonResource defines the resource implementation.
+type CaseCloseReasonResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCaseCloseReasonResource() resource.Resource {
+	return &CaseCloseReasonResource{}
+}
+
+func (r *CaseCloseReasonResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_close_reason"
+}
+
+func (r *CaseCloseReasonResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Case Close Reason resource. One of the reasons the Case is closed with.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Case Close Reason. Never changes.",
+			},
+			"close_reason_group_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The Case Close Reason Group ID. Changing this forces a new resource to be created.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Case Close Reason name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Case Close Reason.",
+			},
+		},
+	}
+}
+
+func (r *CaseCloseReasonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaseCloseReasonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CaseCloseReasonResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &close_reasons.CreateCloseReasonParams{
+		Context:            ctx,
+		CloseReasonGroupID: data.CloseReasonGroupID.ValueString(),
+		Body: &models.CloseReasonsCreateCloseReasonBody{
+			Name:        data.Name.ValueStringPointer(),
+			Description: data.Description.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.CloseReasons.CreateCloseReasonWithParams(input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseCloseReasonToTF(httpResp.GetPayload(), data.CloseReasonGroupID))...)
+}
+
+func (r *CaseCloseReasonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseCloseReasonResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &close_reasons.LocateCloseReasonParams{
+		Context:            ctx,
+		CloseReasonGroupID: data.CloseReasonGroupID.ValueString(),
+		ID:                 data.ID.ValueString(),
+		Fields:             caseCloseReasonFields,
+	}
+
+	httpResp, err := r.client.CloseReasons.LocateCloseReason(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	reason := httpResp.GetPayload().CloseReason
+	if reason == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseCloseReasonToTF(reason, data.CloseReasonGroupID))...)
+}
+
+func (r *CaseCloseReasonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CaseCloseReasonResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &close_reasons.UpdateCloseReasonParams{
+		Context:            ctx,
+		CloseReasonGroupID: state.CloseReasonGroupID.ValueString(),
+		ID:                 state.ID.ValueString(),
+		Input: &models.CasesInputCloseReason{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.CloseReasons.UpdateCloseReason(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseCloseReasonToTF(httpResp.GetPayload(), state.CloseReasonGroupID))...)
+}
+
+func (r *CaseCloseReasonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseCloseReasonResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &close_reasons.DeleteCloseReasonParams{
+		Context:            ctx,
+		CloseReasonGroupID: data.CloseReasonGroupID.ValueString(),
+		ID:                 data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.CloseReasons.DeleteCloseReasonWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CaseCloseReasonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "close_reason_group_id", "id")
+}
+
+func caseCloseReasonToTF(in *models.CasesCloseReason, groupID types.String) *CaseCloseReasonResourceModel {
+	out := &CaseCloseReasonResourceModel{
+		ID:                 types.StringValue(in.ID),
+		CloseReasonGroupID: groupID,
+		Name:               types.StringValue(in.Name),
+		Description:        stringOrNull(in.Description),
+	}
+
+	if in.CloseReasonGroupID != "" {
+		out.CloseReasonGroupID = types.StringValue(in.CloseReasonGroupID)
+	}
+
+	return out
+}
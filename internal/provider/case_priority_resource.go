@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/priorities"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// casePriorityFields are the Case Priority fields returned by the API.
+var casePriorityFields = []string{"id", "name", "description", "color"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CasePriorityResource{}
+var _ resource.ResourceWithImportState = &CasePriorityResource{}
+
+type CasePriorityResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+}
+
+// CasePriorityResource defines the resource implementation.
+type CasePriorityResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCasePriorityResource() resource.Resource {
+	return &CasePriorityResource{}
+}
+
+func (r *CasePriorityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_priority"
+}
+
+func (r *CasePriorityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Case Priority dictionary resource. Referenced by the Cases and the SLA conditions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Case Priority. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Case Priority name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Case Priority.",
+			},
+			"color": schema.StringAttribute{
+				Optional:    true,
+				Description: "The color the Case Priority is displayed with, e.g. `#FF0000`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`), "must be a hex color, e.g. #FF0000"),
+				},
+			},
+		},
+	}
+}
+
+func (r *CasePriorityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CasePriorityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CasePriorityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &priorities.CreatePriorityParams{
+		Context: ctx,
+		Fields:  casePriorityFields,
+		Input: &models.CasesInputPriority{
+			Name:        data.Name.ValueString(),
+			Description: data.Description.ValueString(),
+			Color:       data.Color.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.Priorities.CreatePriority(input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, casePriorityToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CasePriorityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CasePriorityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &priorities.LocatePriorityParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+		Fields:  casePriorityFields,
+	}
+
+	httpResp, err := r.client.Priorities.LocatePriority(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	priority := httpResp.GetPayload().Priority
+	if priority == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, casePriorityToTF(priority))...)
+}
+
+func (r *CasePriorityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CasePriorityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &priorities.UpdatePriorityParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Fields:  casePriorityFields,
+		Input: &models.CasesInputPriority{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+			Color:       plan.Color.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.Priorities.UpdatePriority(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, casePriorityToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CasePriorityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CasePriorityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &priorities.DeletePriorityParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.Priorities.DeletePriorityWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CasePriorityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func casePriorityToTF(in *models.CasesPriority) *CasePriorityResourceModel {
+	return &CasePriorityResourceModel{
+		ID:          types.StringValue(in.ID),
+		Name:        types.StringValue(in.Name),
+		Description: stringOrNull(in.Description),
+		Color:       stringOrNull(in.Color),
+	}
+}
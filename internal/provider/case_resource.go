@@ -0,0 +1,725 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/case_comments"
+	"github.com/webitel/webitel-openapi-client-go/client/cases"
+	"github.com/webitel/webitel-openapi-client-go/client/related_cases"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// caseFields are the Case fields returned by the API.
+var caseFields = []string{
+	"id", "etag", "name", "subject", "description", "contact_info",
+	"reporter", "impacted", "assignee", "group", "service", "priority", "source", "status",
+}
+
+// caseUpdateMask are the Case fields replaced on update.
+var caseUpdateMask = []string{
+	"subject", "description", "contact_info",
+	"reporter", "impacted", "assignee", "group", "service", "priority", "source", "status",
+}
+
+var caseCommentFields = []string{"id", "etag", "text"}
+
+var caseRelatedFields = []string{"id", "etag", "primary_case", "related_case", "relation_type"}
+
+// caseRelationTypes are the supported relations between the Cases.
+var caseRelationTypes = []string{
+	string(models.CasesRelationTypeDUPLICATES),
+	string(models.CasesRelationTypeISDUPLICATEDBY),
+	string(models.CasesRelationTypeBLOCKS),
+	string(models.CasesRelationTypeISBLOCKEDBY),
+	string(models.CasesRelationTypeCAUSES),
+	string(models.CasesRelationTypeISCAUSEDBY),
+	string(models.CasesRelationTypeISCHILDOF),
+	string(models.CasesRelationTypeISPARENTOF),
+	string(models.CasesRelationTypeRELATESTO),
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaseResource{}
+var _ resource.ResourceWithImportState = &CaseResource{}
+
+type CaseResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	ETag              types.String `tfsdk:"etag"`
+	Name              types.String `tfsdk:"name"`
+	Subject           types.String `tfsdk:"subject"`
+	Description       types.String `tfsdk:"description"`
+	ContactInfo       types.String `tfsdk:"contact_info"`
+	ReporterID        types.String `tfsdk:"reporter_id"`
+	ImpactedID        types.String `tfsdk:"impacted_id"`
+	AssigneeID        types.String `tfsdk:"assignee_id"`
+	GroupID           types.String `tfsdk:"group_id"`
+	ServiceID         types.String `tfsdk:"service_id"`
+	PriorityID        types.String `tfsdk:"priority_id"`
+	SourceID          types.String `tfsdk:"source_id"`
+	StatusConditionID types.String `tfsdk:"status_condition_id"`
+	Related           types.Set    `tfsdk:"related"`
+	Comments          types.Set    `tfsdk:"comments"`
+}
+
+type CaseResourceRelated struct {
+	CaseID       types.String `tfsdk:"case_id"`
+	RelationType types.String `tfsdk:"relation_type"`
+}
+
+// CaseResource defines the resource implementation.
+type CaseResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCaseResource() resource.Resource {
+	return &CaseResource{}
+}
+
+func (r *CaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case"
+}
+
+func (r *CaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Case resource. The request of the Contact registered for the Case Service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Case. Never changes.",
+			},
+			"etag": schema.StringAttribute{
+				Computed: true,
+				Description: "Unique ID of the latest version of the update. " +
+					"This ID changes after any update to the underlying value(s).",
+			},
+			"name": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The Case number assigned by the Service Catalog prefix, e.g. `SUP_42`.",
+			},
+			"subject": schema.StringAttribute{
+				Required:    true,
+				Description: "The Case subject.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Case details. Multi-lined text.",
+			},
+			"contact_info": schema.StringAttribute{
+				Optional:    true,
+				Description: "The contact details of the reporter, e.g. the phone number or the email to reach them.",
+			},
+			"reporter_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Contact who reported the Case.",
+			},
+			"impacted_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the Contact impacted by the Case. Defaults to the reporter.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"assignee_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the Contact the Case is assigned to.",
+			},
+			"group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the contact group responsible for the Case.",
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Case Service.",
+			},
+			"priority_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the Case Priority. Defaults to the priority chosen by the server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Case Source.",
+			},
+			"status_condition_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "The ID of the current Case Status condition. " +
+					"Defaults to the initial condition of the Case Service Catalog status.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"related": schema.SetNestedAttribute{
+				Optional:    true,
+				Description: "The links of the Case to the other Cases.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"case_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the related Case.",
+						},
+						"relation_type": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(string(models.CasesRelationTypeRELATESTO)),
+							Description: "The relation of the Case to the related one, e.g. `BLOCKS`. Defaults to `RELATES_TO`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(caseRelationTypes...),
+							},
+						},
+					},
+				},
+			},
+			"comments": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The comments published to the Case. Changing the text of the comment republishes it.",
+			},
+		},
+	}
+}
+
+func (r *CaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &cases.CreateCaseParams{
+		Context: ctx,
+		Fields:  caseFields,
+		Input: &models.CasesInputCreateCase{
+			Subject:     data.Subject.ValueString(),
+			Description: data.Description.ValueString(),
+			ContactInfo: data.ContactInfo.ValueString(),
+			Reporter:    generalLookupOrNil(data.ReporterID),
+			Impacted:    generalLookupOrNil(data.ImpactedID),
+			Assignee:    generalLookupOrNil(data.AssigneeID),
+			Group:       generalLookupOrNil(data.GroupID),
+			Service:     generalLookupOrNil(data.ServiceID),
+			Priority:    generalLookupOrNil(data.PriorityID),
+			Source:      generalLookupOrNil(data.SourceID),
+			Status:      generalLookupOrNil(data.StatusConditionID),
+		},
+	}
+
+	httpResp, err := r.client.Cases.CreateCase(input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	id := httpResp.GetPayload().ID
+
+	// Keep the created Case in state even if linking it fails, so it is
+	// updated or destroyed on the next apply
+	state := caseToTF(httpResp.GetPayload())
+	state.Related = types.SetNull(caseRelatedSchema())
+	state.Comments = types.SetNull(types.StringType)
+
+	resp.Diagnostics.Append(r.syncRelated(ctx, id, data.Related)...)
+	resp.Diagnostics.Append(r.syncComments(ctx, id, data.Comments)...)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+		return
+	}
+
+	out, err := r.read(ctx, id)
+	if err != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, casePlanned(out, &data))...)
+}
+
+func (r *CaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.read(ctx, data.ID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, casePlanned(out, &data))...)
+}
+
+func (r *CaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &cases.UpdateCaseParams{
+		Context:   ctx,
+		InputEtag: state.ETag.ValueString(),
+		Fields:    caseFields,
+		XJSONMask: caseUpdateMask,
+		Input: &models.UpdateCaseParamsBody{
+			Subject:     plan.Subject.ValueString(),
+			Description: plan.Description.ValueString(),
+			ContactInfo: plan.ContactInfo.ValueString(),
+			Reporter:    generalLookupOrNil(plan.ReporterID),
+			Impacted:    generalLookupOrNil(plan.ImpactedID),
+			Assignee:    generalLookupOrNil(plan.AssigneeID),
+			Group:       generalLookupOrNil(plan.GroupID),
+			Service:     generalLookupOrNil(plan.ServiceID),
+			Priority:    generalLookupOrNil(plan.PriorityID),
+			Source:      generalLookupOrNil(plan.SourceID),
+			Status:      generalLookupOrNil(plan.StatusConditionID),
+		},
+	}
+
+	httpResp, err := r.client.Cases.UpdateCase(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncRelated(ctx, state.ID.ValueString(), plan.Related)...)
+	resp.Diagnostics.Append(r.syncComments(ctx, state.ID.ValueString(), plan.Comments)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.read(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, casePlanned(out, &plan))...)
+}
+
+func (r *CaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &cases.DeleteCaseParams{
+		Context: ctx,
+		Etag:    data.ETag.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.Cases.DeleteCase(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// read returns the Case with its related Cases and comments.
+func (r *CaseResource) read(ctx context.Context, id string) (*CaseResourceModel, error) {
+	httpResp, err := r.client.Cases.LocateCase(&cases.LocateCaseParams{Context: ctx, Etag: id, Fields: caseFields})
+	if err != nil {
+		return nil, err
+	}
+
+	out := caseToTF(httpResp.GetPayload())
+
+	related, err := r.listRelated(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(related) > 0 {
+		elements := make([]attr.Value, 0, len(related))
+		for _, v := range related {
+			elements = append(elements, types.ObjectValueMust(caseRelatedSchema().AttrTypes, map[string]attr.Value{
+				"case_id":       types.StringValue(v.RelatedCase.ID),
+				"relation_type": types.StringValue(string(*v.RelationType)),
+			}))
+		}
+
+		out.Related = types.SetValueMust(caseRelatedSchema(), elements)
+	}
+
+	comments, err := r.listComments(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(comments) > 0 {
+		texts := make([]string, 0, len(comments))
+		for _, v := range comments {
+			texts = append(texts, v.Text)
+		}
+
+		out.Comments = stringsToSet(texts)
+	}
+
+	return out, nil
+}
+
+// listRelated returns the links of the Case to the other Cases.
+func (r *CaseResource) listRelated(ctx context.Context, id string) ([]*models.CasesRelatedCase, error) {
+	var out []*models.CasesRelatedCase
+	for page := int32(1); ; page++ {
+		size := int32(100)
+		httpResp, err := r.client.RelatedCases.ListRelatedCases(&related_cases.ListRelatedCasesParams{
+			Context:         ctx,
+			PrimaryCaseEtag: id,
+			Fields:          caseRelatedFields,
+			Page:            &page,
+			Size:            &size,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range httpResp.GetPayload().Data {
+			// The links of the other Cases to this one are listed too
+			if v.PrimaryCase == nil || v.PrimaryCase.ID != id || v.RelatedCase == nil || v.RelationType == nil {
+				continue
+			}
+
+			out = append(out, v)
+		}
+
+		if !httpResp.GetPayload().Next {
+			return out, nil
+		}
+	}
+}
+
+// listComments returns the comments of the Case.
+func (r *CaseResource) listComments(ctx context.Context, id string) ([]*models.CasesCaseComment, error) {
+	var out []*models.CasesCaseComment
+	for page := int32(1); ; page++ {
+		size := int32(100)
+		httpResp, err := r.client.CaseComments.ListComments(&case_comments.ListCommentsParams{
+			Context:  ctx,
+			CaseEtag: id,
+			Fields:   caseCommentFields,
+			Page:     &page,
+			Size:     &size,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, httpResp.GetPayload().Items...)
+		if !httpResp.GetPayload().Next {
+			return out, nil
+		}
+	}
+}
+
+// syncRelated links the Case to the planned Cases and removes the other links.
+func (r *CaseResource) syncRelated(ctx context.Context, id string, planned types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var related []CaseResourceRelated
+	if !planned.IsNull() && !planned.IsUnknown() {
+		diags.Append(planned.ElementsAs(ctx, &related, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	want := make(map[string]string, len(related))
+	for _, v := range related {
+		want[v.CaseID.ValueString()] = v.RelationType.ValueString()
+	}
+
+	existing, err := r.listRelated(ctx, id)
+	if err != nil {
+		diags.AddError("Unable to Read Related Cases", err.Error())
+
+		return diags
+	}
+
+	for _, v := range existing {
+		if relationType, ok := want[v.RelatedCase.ID]; ok && relationType == string(*v.RelationType) {
+			delete(want, v.RelatedCase.ID)
+
+			continue
+		}
+
+		_, err := r.client.RelatedCases.DeleteRelatedCaseWithParams(&related_cases.DeleteRelatedCaseParams{Context: ctx, Etag: v.Etag})
+		if err != nil && !isNotFound(err) {
+			diags.AddError("Unable to Unlink Related Case", err.Error())
+
+			return diags
+		}
+	}
+
+	for caseID, relationType := range want {
+		_, err := r.client.RelatedCases.CreateRelatedCase(&related_cases.CreateRelatedCaseParams{
+			Context:         ctx,
+			PrimaryCaseEtag: id,
+			Fields:          caseRelatedFields,
+			Input: &models.CasesCreateInputRelatedCase{
+				RelatedCase:  &models.GeneralLookup{ID: caseID},
+				RelationType: models.NewCasesRelationType(models.CasesRelationType(relationType)),
+			},
+		})
+		if err != nil {
+			diags.AddError("Unable to Link Related Case", err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// syncComments publishes the planned comments missing on the Case and
+// deletes the others.
+func (r *CaseResource) syncComments(ctx context.Context, id string, planned types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var texts []string
+	if !planned.IsNull() && !planned.IsUnknown() {
+		diags.Append(planned.ElementsAs(ctx, &texts, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	want := make(map[string]bool, len(texts))
+	for _, v := range texts {
+		want[v] = true
+	}
+
+	existing, err := r.listComments(ctx, id)
+	if err != nil {
+		diags.AddError("Unable to Read Case Comments", err.Error())
+
+		return diags
+	}
+
+	for _, v := range existing {
+		if want[v.Text] {
+			delete(want, v.Text)
+
+			continue
+		}
+
+		_, err := r.client.CaseComments.DeleteComment(&case_comments.DeleteCommentParams{Context: ctx, Etag: v.Etag})
+		if err != nil && !isNotFound(err) {
+			diags.AddError("Unable to Delete Case Comment", err.Error())
+
+			return diags
+		}
+	}
+
+	// Publish in the configured order
+	for _, text := range texts {
+		if !want[text] {
+			continue
+		}
+
+		_, err := r.client.CaseComments.PublishComment(&case_comments.PublishCommentParams{
+			Context:  ctx,
+			CaseEtag: id,
+			Fields:   caseCommentFields,
+			Input:    &models.CasesInputCaseComment{Text: text},
+		})
+		if err != nil {
+			diags.AddError("Unable to Publish Case Comment", err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func caseRelatedSchema() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"case_id":       types.StringType,
+			"relation_type": types.StringType,
+		},
+	}
+}
+
+// casePlanned keeps the unset optional collections null, as the Case may
+// be linked and commented outside of Terraform, and the empty ones empty.
+func casePlanned(out, planned *CaseResourceModel) *CaseResourceModel {
+	switch {
+	case planned.Related.IsNull():
+		out.Related = types.SetNull(caseRelatedSchema())
+	case out.Related.IsNull():
+		out.Related = types.SetValueMust(caseRelatedSchema(), nil)
+	}
+
+	switch {
+	case planned.Comments.IsNull():
+		out.Comments = types.SetNull(types.StringType)
+	case out.Comments.IsNull():
+		out.Comments = types.SetValueMust(types.StringType, nil)
+	}
+
+	return out
+}
+
+func caseToTF(in *models.CasesCase) *CaseResourceModel {
+	out := &CaseResourceModel{
+		ID:                types.StringValue(in.ID),
+		ETag:              types.StringValue(in.Etag),
+		Name:              types.StringValue(in.Name),
+		Subject:           types.StringValue(in.Subject),
+		Description:       stringOrNull(in.Description),
+		ContactInfo:       stringOrNull(in.ContactInfo),
+		ReporterID:        generalLookupToTF(in.Reporter),
+		ImpactedID:        generalLookupToTF(in.Impacted),
+		AssigneeID:        generalLookupToTF(in.Assignee),
+		GroupID:           types.StringNull(),
+		ServiceID:         generalLookupToTF(in.Service),
+		PriorityID:        types.StringNull(),
+		SourceID:          types.StringNull(),
+		StatusConditionID: generalLookupToTF(in.Status),
+		Related:           types.SetNull(caseRelatedSchema()),
+		Comments:          types.SetNull(types.StringType),
+	}
+
+	if in.Group != nil && in.Group.ID != "" {
+		out.GroupID = types.StringValue(in.Group.ID)
+	}
+
+	if in.Priority != nil && in.Priority.ID != "" {
+		out.PriorityID = types.StringValue(in.Priority.ID)
+	}
+
+	if in.Source != nil && in.Source.ID != "" {
+		out.SourceID = types.StringValue(in.Source.ID)
+	}
+
+	return out
+}
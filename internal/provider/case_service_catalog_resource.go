@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/catalogs"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// caseServiceCatalogFields are the Case Service Catalog fields returned by the API.
+var caseServiceCatalogFields = []string{
+	"id", "name", "description", "code", "prefix", "state",
+	"sla", "status", "close_reason_group", "teams", "skills",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaseServiceCatalogResource{}
+var _ resource.ResourceWithImportState = &CaseServiceCatalogResource{}
+
+type CaseServiceCatalogResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Code               types.String `tfsdk:"code"`
+	Prefix             types.String `tfsdk:"prefix"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	SLAID              types.String `tfsdk:"sla_id"`
+	StatusID           types.String `tfsdk:"status_id"`
+	CloseReasonGroupID types.String `tfsdk:"close_reason_group_id"`
+	TeamIDs            types.Set    `tfsdk:"team_ids"`
+	SkillIDs           types.Set    `tfsdk:"skill_ids"`
+}
+
+// CaseServiceCatalogResource defines the resource implementation.
+type CaseServiceCatalogResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCaseServiceCatalogResource() resource.Resource {
+	return &CaseServiceCatalogResource{}
+}
+
+func (r *CaseServiceCatalogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_service_catalog"
+}
+
+func (r *CaseServiceCatalogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Case Service Catalog resource. The root of the Services tree the Cases are registered for.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Case Service Catalog. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Case Service Catalog name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Case Service Catalog.",
+			},
+			"code": schema.StringAttribute{
+				Optional:    true,
+				Description: "The code of the Case Service Catalog for the external integrations.",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "The prefix of the Case names registered for the Case Service Catalog.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the Case Service Catalog is active. Defaults to `true`.",
+			},
+			"sla_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the default Case SLA.",
+			},
+			"status_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Case Status the Cases move through.",
+			},
+			"close_reason_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the Case Close Reason Group the Cases are closed with.",
+			},
+			"team_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The IDs of the agent teams handling the Cases.",
+			},
+			"skill_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The IDs of the skills required to handle the Cases.",
+			},
+		},
+	}
+}
+
+func (r *CaseServiceCatalogResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaseServiceCatalogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CaseServiceCatalogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := caseServiceCatalogInput(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := &models.CasesCreateCatalogRequest{
+		Name:             input.Name,
+		Description:      input.Description,
+		Code:             input.Code,
+		Prefix:           input.Prefix,
+		State:            input.State,
+		SLA:              input.SLA,
+		Status:           input.Status,
+		CloseReasonGroup: input.CloseReasonGroup,
+		Teams:            input.Teams,
+		Skills:           input.Skills,
+	}
+
+	httpResp, err := r.client.Catalogs.CreateCatalogWithParams(&catalogs.CreateCatalogParams{Context: ctx, Body: body})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseServiceCatalogToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CaseServiceCatalogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseServiceCatalogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &catalogs.LocateCatalogParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+		Fields:  caseServiceCatalogFields,
+	}
+
+	httpResp, err := r.client.Catalogs.LocateCatalog(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	catalog := httpResp.GetPayload().Catalog
+	if catalog == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseServiceCatalogToTF(catalog))...)
+}
+
+func (r *CaseServiceCatalogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CaseServiceCatalogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := caseServiceCatalogInput(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &catalogs.UpdateCatalogParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Input:   input,
+	}
+
+	httpResp, err := r.client.Catalogs.UpdateCatalogWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseServiceCatalogToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CaseServiceCatalogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseServiceCatalogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &catalogs.DeleteCatalogParams{
+		Context: ctx,
+		ID:      []string{data.ID.ValueString()},
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.Catalogs.DeleteCatalogWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CaseServiceCatalogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func caseServiceCatalogInput(ctx context.Context, data *CaseServiceCatalogResourceModel) (*models.CasesInputCatalog, diag.Diagnostics) {
+	teams, diags := generalLookups(ctx, data.TeamIDs)
+
+	skills, d := generalLookups(ctx, data.SkillIDs)
+	diags.Append(d...)
+
+	return &models.CasesInputCatalog{
+		Name:             data.Name.ValueString(),
+		Description:      data.Description.ValueString(),
+		Code:             data.Code.ValueString(),
+		Prefix:           data.Prefix.ValueString(),
+		State:            data.Enabled.ValueBool(),
+		SLA:              generalLookupOrNil(data.SLAID),
+		Status:           generalLookupOrNil(data.StatusID),
+		CloseReasonGroup: generalLookupOrNil(data.CloseReasonGroupID),
+		Teams:            teams,
+		Skills:           skills,
+	}, diags
+}
+
+func caseServiceCatalogToTF(in *models.CasesCatalog) *CaseServiceCatalogResourceModel {
+	return &CaseServiceCatalogResourceModel{
+		ID:                 types.StringValue(in.ID),
+		Name:               types.StringValue(in.Name),
+		Description:        stringOrNull(in.Description),
+		Code:               stringOrNull(in.Code),
+		Prefix:             stringOrNull(in.Prefix),
+		Enabled:            types.BoolValue(in.State),
+		SLAID:              generalLookupToTF(in.SLA),
+		StatusID:           generalLookupToTF(in.Status),
+		CloseReasonGroupID: generalLookupToTF(in.CloseReasonGroup),
+		TeamIDs:            generalLookupsToTF(in.Teams),
+		SkillIDs:           generalLookupsToTF(in.Skills),
+	}
+}
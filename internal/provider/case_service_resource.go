@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/services"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// caseServiceFields are the Case Service fields returned by the API.
+var caseServiceFields = []string{
+	"id", "name", "description", "code", "state",
+	"sla", "assignee", "group", "catalog_id", "root_id",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaseServiceResource{}
+var _ resource.ResourceWithImportState = &CaseServiceResource{}
+
+type CaseServiceResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	CatalogID   types.String `tfsdk:"catalog_id"`
+	RootID      types.String `tfsdk:"root_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Code        types.String `tfsdk:"code"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	SLAID       types.String `tfsdk:"sla_id"`
+	AssigneeID  types.String `tfsdk:"assignee_id"`
+	GroupID     types.String `tfsdk:"group_id"`
+}
+
+// CaseServiceResource defines the resource implementation.
+type CaseServiceResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCaseServiceResource() resource.Resource {
+	return &CaseServiceResource{}
+}
+
+func (r *CaseServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_service"
+}
+
+func (r *CaseServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Case Service resource. The node of the Case Service Catalog tree the Cases are registered for.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Case Service. Never changes.",
+			},
+			"catalog_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description: "The ID of the parent Case Service Catalog, or of the parent Case Service for the nested Services. " +
+					"Changing this forces a new resource to be created.",
+			},
+			"root_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "The ID of the root Case Service Catalog of the nested Services. " +
+					"Changing this forces a new resource to be created.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Case Service name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Case Service.",
+			},
+			"code": schema.StringAttribute{
+				Optional:    true,
+				Description: "The code of the Case Service for the external integrations.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the Case Service is active. Defaults to `true`.",
+			},
+			"sla_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the Case SLA overriding the one of the Case Service Catalog.",
+			},
+			"assignee_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the contact the Cases are assigned to.",
+			},
+			"group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the contact group responsible for the Cases.",
+			},
+		},
+	}
+}
+
+func (r *CaseServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaseServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CaseServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.CasesCreateServiceRequest{
+		CatalogID:   data.CatalogID.ValueString(),
+		RootID:      data.RootID.ValueString(),
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Code:        data.Code.ValueString(),
+		State:       data.Enabled.ValueBool(),
+		SLA:         generalLookupOrNil(data.SLAID),
+		Assignee:    generalLookupOrNil(data.AssigneeID),
+		Group:       caseServiceGroupOrNil(data.GroupID),
+	}
+
+	httpResp, err := r.client.Services.CreateServiceWithParams(&services.CreateServiceParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseServiceToTF(httpResp.GetPayload(), &data))...)
+}
+
+func (r *CaseServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &services.LocateServiceParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+		Fields:  caseServiceFields,
+	}
+
+	httpResp, err := r.client.Services.LocateService(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	service := httpResp.GetPayload().Service
+	if service == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseServiceToTF(service, &data))...)
+}
+
+func (r *CaseServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CaseServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &services.UpdateServiceParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Input: &models.CasesInputService{
+			CatalogID:   plan.CatalogID.ValueString(),
+			RootID:      plan.RootID.ValueString(),
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+			Code:        plan.Code.ValueString(),
+			State:       plan.Enabled.ValueBool(),
+			SLA:         generalLookupOrNil(plan.SLAID),
+			Assignee:    generalLookupOrNil(plan.AssigneeID),
+			Group:       caseServiceGroupOrNil(plan.GroupID),
+		},
+	}
+
+	httpResp, err := r.client.Services.UpdateServiceWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseServiceToTF(httpResp.GetPayload(), &plan))...)
+}
+
+func (r *CaseServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &services.DeleteServiceParams{
+		Context: ctx,
+		ID:      []string{data.ID.ValueString()},
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.Services.DeleteServiceWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CaseServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// caseServiceGroupOrNil returns the contact group lookup or nil when the id is not set.
+func caseServiceGroupOrNil(id types.String) *models.GeneralExtendedLookup {
+	if id.IsNull() || id.IsUnknown() || id.ValueString() == "" {
+		return nil
+	}
+
+	return &models.GeneralExtendedLookup{ID: id.ValueString()}
+}
+
+func caseServiceToTF(in *models.CasesService, prior *CaseServiceResourceModel) *CaseServiceResourceModel {
+	out := &CaseServiceResourceModel{
+		ID:          types.StringValue(in.ID),
+		CatalogID:   prior.CatalogID,
+		RootID:      stringOrNull(in.RootID),
+		Name:        types.StringValue(in.Name),
+		Description: stringOrNull(in.Description),
+		Code:        stringOrNull(in.Code),
+		Enabled:     types.BoolValue(in.State),
+		SLAID:       generalLookupToTF(in.SLA),
+		AssigneeID:  generalLookupToTF(in.Assignee),
+		GroupID:     types.StringNull(),
+	}
+
+	if in.CatalogID != "" {
+		out.CatalogID = types.StringValue(in.CatalogID)
+	}
+
+	if in.Group != nil && in.Group.ID != "" {
+		out.GroupID = types.StringValue(in.Group.ID)
+	}
+
+	return out
+}
@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/sla_conditions"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// caseSLAConditionFields are the Case SLA condition fields returned by the API.
+var caseSLAConditionFields = []string{"id", "name", "priorities", "reaction_time", "resolution_time", "sla_id"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaseSLAConditionResource{}
+var _ resource.ResourceWithImportState = &CaseSLAConditionResource{}
+
+type CaseSLAConditionResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	SLAID          types.String `tfsdk:"sla_id"`
+	Name           types.String `tfsdk:"name"`
+	PriorityIDs    types.Set    `tfsdk:"priority_ids"`
+	ReactionTime   types.String `tfsdk:"reaction_time"`
+	ResolutionTime types.String `tfsdk:"resolution_time"`
+}
+
+// CaseSLAConditionResource defines the resource implementation.
+type CaseSLAConditionResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCaseSLAConditionResource() resource.Resource {
+	return &CaseSLAConditionResource{}
+}
+
+func (r *CaseSLAConditionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_sla_condition"
+}
+
+func (r *CaseSLAConditionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Case SLA condition resource. Overrides the Case SLA times for the Cases of the given priorities.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Case SLA condition. Never changes.",
+			},
+			"sla_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The Case SLA ID. Changing this forces a new resource to be created.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Case SLA condition name.",
+			},
+			"priority_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The IDs of the Case Priorities the condition applies to.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"reaction_time": schema.StringAttribute{
+				Required:    true,
+				Description: "The time to react to the Case in milliseconds.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(millisecondsRegexp, "must be a duration in milliseconds"),
+				},
+			},
+			"resolution_time": schema.StringAttribute{
+				Required:    true,
+				Description: "The time to resolve the Case in milliseconds.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(millisecondsRegexp, "must be a duration in milliseconds"),
+				},
+			},
+		},
+	}
+}
+
+func (r *CaseSLAConditionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaseSLAConditionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CaseSLAConditionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorities, diags := generalLookups(ctx, data.PriorityIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &sla_conditions.CreateSLAConditionParams{
+		Context: ctx,
+		SLAID:   data.SLAID.ValueString(),
+		Body: &models.SLAConditionsCreateSLAConditionBody{
+			Name:           data.Name.ValueStringPointer(),
+			Priorities:     priorities,
+			ReactionTime:   data.ReactionTime.ValueStringPointer(),
+			ResolutionTime: data.ResolutionTime.ValueStringPointer(),
+		},
+	}
+
+	httpResp, err := r.client.SLAConditions.CreateSLAConditionWithParams(input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseSLAConditionToTF(httpResp.GetPayload(), data.SLAID))...)
+}
+
+func (r *CaseSLAConditionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseSLAConditionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &sla_conditions.LocateSLAConditionParams{
+		Context: ctx,
+		SLAID:   data.SLAID.ValueString(),
+		ID:      data.ID.ValueString(),
+		Fields:  caseSLAConditionFields,
+	}
+
+	httpResp, err := r.client.SLAConditions.LocateSLACondition(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	condition := httpResp.GetPayload().SLACondition
+	if condition == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseSLAConditionToTF(condition, data.SLAID))...)
+}
+
+func (r *CaseSLAConditionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CaseSLAConditionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	priorities, diags := generalLookups(ctx, plan.PriorityIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &sla_conditions.UpdateSLAConditionParams{
+		Context: ctx,
+		SLAID:   state.SLAID.ValueString(),
+		ID:      state.ID.ValueString(),
+		Input: &models.CasesInputSLACondition{
+			SLAID:          state.SLAID.ValueString(),
+			Name:           plan.Name.ValueString(),
+			Priorities:     priorities,
+			ReactionTime:   plan.ReactionTime.ValueString(),
+			ResolutionTime: plan.ResolutionTime.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.SLAConditions.UpdateSLACondition(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseSLAConditionToTF(httpResp.GetPayload(), state.SLAID))...)
+}
+
+func (r *CaseSLAConditionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseSLAConditionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &sla_conditions.DeleteSLAConditionParams{
+		Context: ctx,
+		SLAID:   data.SLAID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.SLAConditions.DeleteSLAConditionWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CaseSLAConditionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "sla_id", "id")
+}
+
+func caseSLAConditionToTF(in *models.CasesSLACondition, slaID types.String) *CaseSLAConditionResourceModel {
+	out := &CaseSLAConditionResourceModel{
+		ID:             types.StringValue(in.ID),
+		SLAID:          slaID,
+		Name:           types.StringValue(in.Name),
+		PriorityIDs:    generalLookupsToTF(in.Priorities),
+		ReactionTime:   types.StringValue(in.ReactionTime),
+		ResolutionTime: types.StringValue(in.ResolutionTime),
+	}
+
+	if in.SLAID != "" {
+		out.SLAID = types.StringValue(in.SLAID)
+	}
+
+	return out
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/s_l_as"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// caseSLAFields are the Case SLA fields returned by the API.
+var caseSLAFields = []string{"id", "name", "description", "calendar", "reaction_time", "resolution_time", "valid_from", "valid_to"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaseSLAResource{}
+var _ resource.ResourceWithImportState = &CaseSLAResource{}
+
+type CaseSLAResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	CalendarID     types.String `tfsdk:"calendar_id"`
+	ReactionTime   types.String `tfsdk:"reaction_time"`
+	ResolutionTime types.String `tfsdk:"resolution_time"`
+	ValidFrom      types.String `tfsdk:"valid_from"`
+	ValidTo        types.String `tfsdk:"valid_to"`
+}
+
+// CaseSLAResource defines the resource implementation.
+type CaseSLAResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCaseSLAResource() resource.Resource {
+	return &CaseSLAResource{}
+}
+
+func (r *CaseSLAResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_sla"
+}
+
+func (r *CaseSLAResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Case SLA resource. Defines the reaction and resolution times of the Cases. " +
+			"The times are counted within the working hours of the calendar.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Case SLA. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Case SLA name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Case SLA.",
+			},
+			"calendar_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the calendar with the working hours.",
+			},
+			"reaction_time": schema.StringAttribute{
+				Required:    true,
+				Description: "The time to react to the Case in milliseconds.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(millisecondsRegexp, "must be a duration in milliseconds"),
+				},
+			},
+			"resolution_time": schema.StringAttribute{
+				Required:    true,
+				Description: "The time to resolve the Case in milliseconds.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(millisecondsRegexp, "must be a duration in milliseconds"),
+				},
+			},
+			"valid_from": schema.StringAttribute{
+				Optional:    true,
+				Description: "The start of the Case SLA validity period. The timestamp in milliseconds since Unix epoch.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(millisecondsRegexp, "must be a timestamp in milliseconds"),
+				},
+			},
+			"valid_to": schema.StringAttribute{
+				Optional:    true,
+				Description: "The end of the Case SLA validity period. The timestamp in milliseconds since Unix epoch.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(millisecondsRegexp, "must be a timestamp in milliseconds"),
+				},
+			},
+		},
+	}
+}
+
+func (r *CaseSLAResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaseSLAResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CaseSLAResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.CasesCreateSLARequest{
+		Name:           data.Name.ValueStringPointer(),
+		Description:    data.Description.ValueString(),
+		Calendar:       generalLookupOrNil(data.CalendarID),
+		ReactionTime:   data.ReactionTime.ValueStringPointer(),
+		ResolutionTime: data.ResolutionTime.ValueStringPointer(),
+		ValidFrom:      data.ValidFrom.ValueString(),
+		ValidTo:        data.ValidTo.ValueString(),
+	}
+
+	httpResp, err := r.client.SlAs.CreateSLAWithParams(&s_l_as.CreateSLAParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseSLAToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CaseSLAResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseSLAResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &s_l_as.LocateSLAParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+		Fields:  caseSLAFields,
+	}
+
+	httpResp, err := r.client.SlAs.LocateSLA(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	sla := httpResp.GetPayload().SLA
+	if sla == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseSLAToTF(sla))...)
+}
+
+func (r *CaseSLAResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CaseSLAResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &s_l_as.UpdateSLAParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Input: &models.CasesInputSLA{
+			Name:           plan.Name.ValueString(),
+			Description:    plan.Description.ValueString(),
+			Calendar:       generalLookupOrNil(plan.CalendarID),
+			ReactionTime:   plan.ReactionTime.ValueString(),
+			ResolutionTime: plan.ResolutionTime.ValueString(),
+			ValidFrom:      plan.ValidFrom.ValueString(),
+			ValidTo:        plan.ValidTo.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.SlAs.UpdateSLAWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseSLAToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CaseSLAResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseSLAResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &s_l_as.DeleteSLAParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.SlAs.DeleteSLAWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CaseSLAResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func caseSLAToTF(in *models.CasesSLA) *CaseSLAResourceModel {
+	return &CaseSLAResourceModel{
+		ID:             types.StringValue(in.ID),
+		Name:           types.StringValue(in.Name),
+		Description:    stringOrNull(in.Description),
+		CalendarID:     generalLookupToTF(in.Calendar),
+		ReactionTime:   types.StringValue(in.ReactionTime),
+		ResolutionTime: types.StringValue(in.ResolutionTime),
+		ValidFrom:      millisecondsToTF(in.ValidFrom),
+		ValidTo:        millisecondsToTF(in.ValidTo),
+	}
+}
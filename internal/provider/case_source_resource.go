@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/sources"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// caseSourceFields are the Case Source fields returned by the API.
+var caseSourceFields = []string{"id", "name", "description", "type"}
+
+// caseSourceTypes are the supported Case Source channel types.
+var caseSourceTypes = []string{
+	string(models.CasesSourceTypeCALL),
+	string(models.CasesSourceTypeCHAT),
+	string(models.CasesSourceTypeSOCIALMEDIA),
+	string(models.CasesSourceTypeEMAIL),
+	string(models.CasesSourceTypeAPI),
+	string(models.CasesSourceTypeMANUAL),
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaseSourceResource{}
+var _ resource.ResourceWithImportState = &CaseSourceResource{}
+
+type CaseSourceResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+}
+
+// CaseSourceResource defines the resource implementation.
+type CaseSourceResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCaseSourceResource() resource.Resource {
+	return &CaseSourceResource{}
+}
+
+func (r *CaseSourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_source"
+}
+
+func (r *CaseSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Case Source dictionary resource. The channel the Cases are registered from.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Case Source. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Case Source name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Case Source.",
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The channel type of the Case Source. One of `CALL`, `CHAT`, `SOCIAL_MEDIA`, `EMAIL`, `API` or `MANUAL`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(caseSourceTypes...),
+				},
+			},
+		},
+	}
+}
+
+func (r *CaseSourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaseSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CaseSourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.CasesCreateSourceRequest{
+		Name:        data.Name.ValueStringPointer(),
+		Description: data.Description.ValueString(),
+		Type:        models.NewCasesSourceType(models.CasesSourceType(data.Type.ValueString())),
+	}
+
+	httpResp, err := r.client.Sources.CreateSourceWithParams(&sources.CreateSourceParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseSourceToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CaseSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseSourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &sources.LocateSourceParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+		Fields:  caseSourceFields,
+	}
+
+	httpResp, err := r.client.Sources.LocateSource(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	source := httpResp.GetPayload().Source
+	if source == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseSourceToTF(source))...)
+}
+
+func (r *CaseSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CaseSourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &sources.UpdateSourceParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Input: &models.CasesInputSource{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+			Type:        models.NewCasesSourceType(models.CasesSourceType(plan.Type.ValueString())),
+		},
+	}
+
+	httpResp, err := r.client.Sources.UpdateSourceWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseSourceToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CaseSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseSourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &sources.DeleteSourceParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.Sources.DeleteSourceWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CaseSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func caseSourceToTF(in *models.CasesSource) *CaseSourceResourceModel {
+	out := &CaseSourceResourceModel{
+		ID:          types.StringValue(in.ID),
+		Name:        types.StringValue(in.Name),
+		Description: stringOrNull(in.Description),
+		Type:        types.StringNull(),
+	}
+
+	if in.Type != nil {
+		out.Type = types.StringValue(string(*in.Type))
+	}
+
+	return out
+}
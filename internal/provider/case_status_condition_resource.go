@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/status_conditions"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// caseStatusConditionFields are the Case Status condition fields returned by the API.
+var caseStatusConditionFields = []string{"id", "name", "description", "initial", "final", "status_id"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CaseStatusConditionResource{}
+var _ resource.ResourceWithImportState = &CaseStatusConditionResource{}
+
+type CaseStatusConditionResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	StatusID    types.String `tfsdk:"status_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Initial     types.Bool   `tfsdk:"initial"`
+	Final       types.Bool   `tfsdk:"final"`
+}
+
+// CaseStatusConditionResource defines the resource implementation.
+type CaseStatusConditionResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCaseStatusConditionResource() resource.Resource {
+	return &CaseStatusConditionResource{}
+}
+
+func (r *CaseStatusConditionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_case_status_condition"
+}
+
+func (r *CaseStatusConditionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Case Status condition resource. Defines one of the states the Case moves through within the Case Status.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Case Status condition. Never changes.",
+			},
+			"status_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The Case Status ID. Changing this forces a new resource to be created.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Case Status condition name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Case Status condition.",
+			},
+			"initial": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the new Cases start in the condition. Defaults to `false`.",
+			},
+			"final": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Case is resolved in the condition. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (r *CaseStatusConditionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CaseStatusConditionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CaseStatusConditionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &status_conditions.CreateStatusConditionParams{
+		Context:  ctx,
+		StatusID: data.StatusID.ValueString(),
+		Body: &models.StatusConditionsCreateStatusConditionBody{
+			Name:        data.Name.ValueStringPointer(),
+			Description: data.Description.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.StatusConditions.CreateStatusConditionWithParams(input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	out := caseStatusConditionToTF(httpResp.GetPayload(), data.StatusID)
+
+	// The initial and final flags are only accepted on update
+	if data.Initial.ValueBool() || data.Final.ValueBool() {
+		updated, err := r.update(ctx, out.ID, &data)
+		if err != nil {
+			// Keep the created condition in state, so it is updated or destroyed
+			// on the next apply
+			resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+			resp.Diagnostics.AddError(
+				"Unable to Create Resource",
+				"An unexpected error occurred while attempting to create the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		out = updated
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *CaseStatusConditionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseStatusConditionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &status_conditions.LocateStatusConditionParams{
+		Context:  ctx,
+		StatusID: data.StatusID.ValueString(),
+		ID:       data.ID.ValueString(),
+		Fields:   caseStatusConditionFields,
+	}
+
+	httpResp, err := r.client.StatusConditions.LocateStatusCondition(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	condition := httpResp.GetPayload().Status
+	if condition == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, caseStatusConditionToTF(condition, data.StatusID))...)
+}
+
+func (r *CaseStatusConditionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CaseStatusConditionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.update(ctx, state.ID, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *CaseStatusConditionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CaseStatusConditionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &status_conditions.DeleteStatusConditionParams{
+		Context:  ctx,
+		StatusID: data.StatusID.ValueString(),
+		ID:       data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.StatusConditions.DeleteStatusConditionWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CaseStatusConditionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "status_id", "id")
+}
+
+// update replaces the condition attributes with the planned ones.
+func (r *CaseStatusConditionResource) update(ctx context.Context, id types.String, plan *CaseStatusConditionResourceModel) (*CaseStatusConditionResourceModel, error) {
+	params := &status_conditions.UpdateStatusConditionParams{
+		Context:  ctx,
+		StatusID: plan.StatusID.ValueString(),
+		ID:       id.ValueString(),
+		Input: &models.CasesInputStatusCondition{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+			Initial:     plan.Initial.ValueBool(),
+			Final:       plan.Final.ValueBool(),
+		},
+	}
+
+	httpResp, err := r.client.StatusConditions.UpdateStatusCondition(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return caseStatusConditionToTF(httpResp.GetPayload(), plan.StatusID), nil
+}
+
+func caseStatusConditionToTF(in *models.CasesStatusCondition, statusID types.String) *CaseStatusConditionResourceModel {
+	out := &CaseStatusConditionResourceModel{
+		ID:          types.StringValue(in.ID),
+		StatusID:    statusID,
+		Name:        types.StringValue(in.Name),
+		Description: stringOrNull(in.Description),
+		Initial:     types.BoolValue(in.Initial),
+		Final:       types.BoolValue(in.Final),
+	}
+
+	if in.StatusID != "" {
+		out.StatusID = types.StringValue(in.StatusID)
+	}
+
+	return out
+}
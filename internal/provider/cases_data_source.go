@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/cases"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CasesDataSource{}
+
+type CasesDataSourceModel struct {
+	Q                 types.String `tfsdk:"q"`
+	ReporterID        types.String `tfsdk:"reporter_id"`
+	ImpactedID        types.String `tfsdk:"impacted_id"`
+	ServiceID         types.String `tfsdk:"service_id"`
+	StatusConditionID types.String `tfsdk:"status_condition_id"`
+	Cases             types.List   `tfsdk:"cases"`
+}
+
+// CasesDataSource defines the data source implementation.
+type CasesDataSource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCasesDataSource() datasource.DataSource {
+	return &CasesDataSource{}
+}
+
+func (d *CasesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cases"
+}
+
+func (d *CasesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Cases matching the search query and filters.",
+		Attributes: map[string]schema.Attribute{
+			"q": schema.StringAttribute{
+				Optional:    true,
+				Description: "The search query matched against the Case name and subject.",
+			},
+			"reporter_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists only the Cases reported by the Contact.",
+			},
+			"impacted_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists only the Cases impacting the Contact.",
+			},
+			"service_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists only the Cases of the Case Service.",
+			},
+			"status_condition_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lists only the Cases in the Case Status condition.",
+			},
+			"cases": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching Cases.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique ID of the Case.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Case number.",
+						},
+						"subject": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Case subject.",
+						},
+						"reporter_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Contact who reported the Case.",
+						},
+						"impacted_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Contact impacted by the Case.",
+						},
+						"service_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Case Service.",
+						},
+						"priority_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Case Priority.",
+						},
+						"source_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Case Source.",
+						},
+						"status_condition_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the current Case Status condition.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CasesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data CasesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := map[string]types.String{
+		"reporter":         data.ReporterID,
+		"impacted":         data.ImpactedID,
+		"service":          data.ServiceID,
+		"status_condition": data.StatusConditionID,
+	}
+
+	elements := []attr.Value{}
+	size := int32(100)
+	for page := int32(1); ; page++ {
+		params := &cases.SearchCasesParams{
+			Context: ctx,
+			Page:    &page,
+			Size:    &size,
+			Q:       data.Q.ValueStringPointer(),
+			Fields:  caseFields,
+		}
+
+		httpResp, err := d.client.Cases.SearchCases(params, casesFilters(filters))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				"An unexpected error occurred while attempting to read the Cases. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		payload := httpResp.GetPayload()
+		for _, v := range payload.Items {
+			c := caseToTF(v)
+			elements = append(elements, types.ObjectValueMust(caseSummarySchema().AttrTypes, map[string]attr.Value{
+				"id":                  c.ID,
+				"name":                c.Name,
+				"subject":             c.Subject,
+				"reporter_id":         c.ReporterID,
+				"impacted_id":         c.ImpactedID,
+				"service_id":          c.ServiceID,
+				"priority_id":         c.PriorityID,
+				"source_id":           c.SourceID,
+				"status_condition_id": c.StatusConditionID,
+			}))
+		}
+
+		if !payload.Next || len(payload.Items) == 0 {
+			break
+		}
+	}
+
+	data.Cases = types.ListValueMust(caseSummarySchema(), elements)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// casesFilters returns the client option setting the filters as "filters[<name>]"
+// query parameters, as the generated client sends the literal "filters[string]".
+func casesFilters(filters map[string]types.String) cases.ClientOption {
+	return func(op *runtime.ClientOperation) {
+		params := op.Params
+		op.Params = runtime.ClientRequestWriterFunc(func(req runtime.ClientRequest, reg strfmt.Registry) error {
+			if err := params.WriteToRequest(req, reg); err != nil {
+				return err
+			}
+
+			for name, v := range filters {
+				if v.IsNull() || v.IsUnknown() {
+					continue
+				}
+
+				if err := req.SetQueryParam("filters["+name+"]", v.ValueString()); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+}
+
+func caseSummarySchema() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":                  types.StringType,
+			"name":                types.StringType,
+			"subject":             types.StringType,
+			"reporter_id":         types.StringType,
+			"impacted_id":         types.StringType,
+			"service_id":          types.StringType,
+			"priority_id":         types.StringType,
+			"source_id":           types.StringType,
+			"status_condition_id": types.StringType,
+		},
+	}
+}
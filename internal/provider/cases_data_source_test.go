@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/webitel/webitel-openapi-client-go/client/cases"
+)
+
+// queryRequest records the query parameters written to the request.
+type queryRequest struct {
+	runtime.ClientRequest
+	query url.Values
+}
+
+func (r *queryRequest) SetQueryParam(name string, values ...string) error {
+	r.query[name] = values
+
+	return nil
+}
+
+func (r *queryRequest) SetTimeout(time.Duration) error {
+	return nil
+}
+
+func TestCasesFilters(t *testing.T) {
+	t.Parallel()
+
+	q := "printer"
+	op := &runtime.ClientOperation{
+		Params: &cases.SearchCasesParams{Q: &q},
+	}
+
+	casesFilters(map[string]types.String{
+		"reporter": types.StringValue("12"),
+		"service":  types.StringValue("3"),
+		"impacted": types.StringNull(),
+	})(op)
+
+	req := &queryRequest{query: url.Values{}}
+	if err := op.Params.WriteToRequest(req, strfmt.Default); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := url.Values{
+		"q":                 {"printer"},
+		"filters[reporter]": {"12"},
+		"filters[service]":  {"3"},
+	}
+
+	if req.query.Encode() != expected.Encode() {
+		t.Errorf("expected query %v, got: %v", expected, req.query)
+	}
+}
@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/cognitive_profile_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CognitiveProfileResource{}
+var _ resource.ResourceWithImportState = &CognitiveProfileResource{}
+
+var cognitiveProfileProviders = []string{
+	string(models.StorageProviderTypeMicrosoft),
+	string(models.StorageProviderTypeGoogle),
+	string(models.StorageProviderTypeElevenLabs),
+}
+
+var cognitiveProfileServices = []string{
+	string(models.StorageServiceTypeSTT),
+	string(models.StorageServiceTypeTTS),
+}
+
+// cognitiveProfileSecretKeys are the properties holding the provider
+// credentials. The API may mask them, so they are kept from the prior data.
+var cognitiveProfileSecretKeys = []string{"key", "apiKey", "token", "secret", "password"}
+
+type CognitiveProfileResourceModel struct {
+	ID           types.String         `tfsdk:"id"`
+	Name         types.String         `tfsdk:"name"`
+	Description  types.String         `tfsdk:"description"`
+	ProviderType types.String         `tfsdk:"provider_type"`
+	Service      types.String         `tfsdk:"service"`
+	Properties   jsontypes.Normalized `tfsdk:"properties"`
+	Default      types.Bool           `tfsdk:"default"`
+	Enabled      types.Bool           `tfsdk:"enabled"`
+}
+
+// CognitiveProfileResource defines the resource implementation.
+type CognitiveProfileResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCognitiveProfileResource() resource.Resource {
+	return &CognitiveProfileResource{}
+}
+
+func (r *CognitiveProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cognitive_profile"
+}
+
+func (r *CognitiveProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Cognitive Profile resource. Configures the speech-to-text or text-to-speech provider " +
+			"used by the IVR prompts and the call transcription.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Cognitive Profile. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Cognitive Profile name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Cognitive Profile.",
+			},
+			"provider_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The speech provider. One of `Microsoft`, `Google` or `ElevenLabs`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(cognitiveProfileProviders...),
+				},
+			},
+			"service": schema.StringAttribute{
+				Required:    true,
+				Description: "The speech service. One of `STT` (speech-to-text) or `TTS` (text-to-speech).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(cognitiveProfileServices...),
+				},
+			},
+			"properties": schema.StringAttribute{
+				Required:   true,
+				Sensitive:  true,
+				CustomType: jsontypes.NormalizedType{},
+				Description: "The provider-specific properties as JSON, e.g. `{\"key\": \"...\", \"region\": \"westeurope\", \"locale\": \"en-US\"}`. " +
+					"The credentials (`key`, `apiKey`, `token`, `secret` and `password`) are not refreshed from the API, " +
+					"so changes made outside of Terraform are not detected for them.",
+			},
+			"default": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Cognitive Profile is used by default for its service. Defaults to `false`.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the Cognitive Profile is enabled. Defaults to `true`.",
+			},
+		},
+	}
+}
+
+func (r *CognitiveProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CognitiveProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CognitiveProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var properties interface{}
+	resp.Diagnostics.Append(data.Properties.Unmarshal(&properties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.StorageCreateCognitiveProfileRequest{
+		Name:        data.Name.ValueStringPointer(),
+		Description: data.Description.ValueString(),
+		Provider:    models.StorageProviderType(data.ProviderType.ValueString()).Pointer(),
+		Service:     models.StorageServiceType(data.Service.ValueString()).Pointer(),
+		Properties:  properties,
+		Default:     data.Default.ValueBool(),
+		Enabled:     data.Enabled.ValueBool(),
+	}
+
+	httpResp, err := r.client.CognitiveProfileService.CreateCognitiveProfileWithParams(&cognitive_profile_service.CreateCognitiveProfileParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	out, diags := cognitiveProfileToTF(httpResp.GetPayload(), &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *CognitiveProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CognitiveProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &cognitive_profile_service.ReadCognitiveProfileParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.CognitiveProfileService.ReadCognitiveProfileWithParams(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	out, diags := cognitiveProfileToTF(httpResp.GetPayload(), &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *CognitiveProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CognitiveProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var properties interface{}
+	resp.Diagnostics.Append(plan.Properties.Unmarshal(&properties)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &cognitive_profile_service.UpdateCognitiveProfileParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Body: &models.StorageUpdateCognitiveProfileRequest{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+			Provider:    models.StorageProviderType(plan.ProviderType.ValueString()).Pointer(),
+			Service:     models.StorageServiceType(plan.Service.ValueString()).Pointer(),
+			Properties:  properties,
+			Default:     plan.Default.ValueBool(),
+			Enabled:     plan.Enabled.ValueBool(),
+		},
+	}
+
+	httpResp, err := r.client.CognitiveProfileService.UpdateCognitiveProfileWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	out, diags := cognitiveProfileToTF(httpResp.GetPayload(), &plan)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *CognitiveProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CognitiveProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &cognitive_profile_service.DeleteCognitiveProfileParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.CognitiveProfileService.DeleteCognitiveProfileWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CognitiveProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// cognitiveProfileToTF converts the Cognitive Profile keeping the
+// credentials of the prior data, as the API may mask them.
+func cognitiveProfileToTF(in *models.StorageCognitiveProfile, prior *CognitiveProfileResourceModel) (*CognitiveProfileResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &CognitiveProfileResourceModel{
+		ID:           types.StringValue(in.ID),
+		Name:         types.StringValue(in.Name),
+		Description:  stringOrNull(in.Description),
+		ProviderType: types.StringNull(),
+		Service:      types.StringNull(),
+		Properties:   prior.Properties,
+		Default:      types.BoolValue(in.Default),
+		Enabled:      types.BoolValue(in.Enabled),
+	}
+
+	if in.Provider != nil {
+		out.ProviderType = types.StringValue(string(*in.Provider))
+	}
+
+	if in.Service != nil {
+		out.Service = types.StringValue(string(*in.Service))
+	}
+
+	properties, ok := in.Properties.(map[string]interface{})
+	if !ok {
+		return out, diags
+	}
+
+	if !prior.Properties.IsNull() && !prior.Properties.IsUnknown() {
+		var priorProperties map[string]interface{}
+		diags.Append(prior.Properties.Unmarshal(&priorProperties)...)
+		for _, key := range cognitiveProfileSecretKeys {
+			if v, ok := priorProperties[key]; ok {
+				properties[key] = v
+			} else {
+				delete(properties, key)
+			}
+		}
+	}
+
+	b, err := json.Marshal(properties)
+	if err != nil {
+		diags.AddError("Unable to encode cognitive profile properties", err.Error())
+
+		return out, diags
+	}
+
+	out.Properties = jsontypes.NewNormalizedValue(string(b))
+
+	return out, diags
+}
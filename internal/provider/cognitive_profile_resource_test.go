@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+func TestCognitiveProfileToTF(t *testing.T) {
+	t.Parallel()
+
+	prior := &CognitiveProfileResourceModel{
+		Properties: jsontypes.NewNormalizedValue(`{"key": "secret", "region": "westeurope"}`),
+	}
+
+	// The API masks the key on read
+	out, diags := cognitiveProfileToTF(&models.StorageCognitiveProfile{
+		ID:         "1",
+		Name:       "azure-tts",
+		Provider:   models.StorageProviderTypeMicrosoft.Pointer(),
+		Service:    models.StorageServiceTypeTTS.Pointer(),
+		Properties: map[string]interface{}{"key": "******", "region": "northeurope", "locale": "en-US"},
+	}, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := jsontypes.NewNormalizedValue(`{"key": "secret", "locale": "en-US", "region": "northeurope"}`)
+	if equal, _ := out.Properties.StringSemanticEquals(context.Background(), expected); !equal {
+		t.Errorf("expected properties %s, got %s", expected, out.Properties)
+	}
+
+	if out.ProviderType.ValueString() != "Microsoft" || out.Service.ValueString() != "TTS" {
+		t.Errorf("expected Microsoft TTS, got %s %s", out.ProviderType, out.Service)
+	}
+
+	// Without the prior data, e.g. on import, the API properties are kept
+	out, _ = cognitiveProfileToTF(&models.StorageCognitiveProfile{
+		ID:         "1",
+		Properties: map[string]interface{}{"key": "******"},
+	}, &CognitiveProfileResourceModel{Properties: jsontypes.NewNormalizedNull()})
+
+	if out.Properties.ValueString() != `{"key":"******"}` {
+		t.Errorf("expected the API properties, got %s", out.Properties)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/communication_type_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CommunicationTypeDataSource{}
+
+// CommunicationTypeDataSource defines the data source implementation.
+type CommunicationTypeDataSource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCommunicationTypeDataSource() datasource.DataSource {
+	return &CommunicationTypeDataSource{}
+}
+
+func (d *CommunicationTypeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_communication_type"
+}
+
+func (d *CommunicationTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the Communication Type by its code or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique ID of the Communication Type.",
+			},
+			"code": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The Communication Type code to look up. Conflicts with `name`.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("code"), path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The Communication Type name to look up. Conflicts with `code`.",
+			},
+			"channel": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Communication Type channel.",
+			},
+			"default": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the Communication Type is the default one for its channel.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Short description of the Communication Type.",
+			},
+		},
+	}
+}
+
+func (d *CommunicationTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CommunicationTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data CommunicationTypeResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The search filters by a substring of the name only,
+	// so fetch the whole dictionary and match exactly.
+	var found []*models.EngineCommunicationType
+	for page := int32(1); ; page++ {
+		size := int32(100)
+		params := &communication_type_service.SearchCommunicationTypeParams{
+			Context: ctx,
+			Page:    &page,
+			Size:    &size,
+		}
+
+		httpResp, err := d.client.CommunicationTypeService.SearchCommunicationType(params)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				"An unexpected error occurred while attempting to read the Communication Types. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		payload := httpResp.GetPayload()
+		for _, v := range payload.Items {
+			if (!data.Code.IsNull() && v.Code == data.Code.ValueString()) ||
+				(!data.Name.IsNull() && v.Name == data.Name.ValueString()) {
+				found = append(found, v)
+			}
+		}
+
+		if !payload.Next || len(payload.Items) == 0 {
+			break
+		}
+	}
+
+	if len(found) != 1 {
+		resp.Diagnostics.AddError(
+			"Unable to Find Communication Type",
+			fmt.Sprintf("Expected exactly one Communication Type with code %s or name %s, got: %d.", data.Code, data.Name, len(found)),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, communicationTypeToTF(found[0]))...)
+}
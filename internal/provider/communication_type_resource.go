@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/communication_type_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// communicationChannels are the channels supported by the Communication Types.
+var communicationChannels = []string{
+	string(models.EngineCommunicationChannelsPhone),
+	string(models.EngineCommunicationChannelsEmail),
+	string(models.EngineCommunicationChannelsMessaging),
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CommunicationTypeResource{}
+var _ resource.ResourceWithImportState = &CommunicationTypeResource{}
+
+type CommunicationTypeResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Code        types.String `tfsdk:"code"`
+	Channel     types.String `tfsdk:"channel"`
+	Default     types.Bool   `tfsdk:"default"`
+	Description types.String `tfsdk:"description"`
+}
+
+// CommunicationTypeResource defines the resource implementation.
+type CommunicationTypeResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewCommunicationTypeResource() resource.Resource {
+	return &CommunicationTypeResource{}
+}
+
+func (r *CommunicationTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_communication_type"
+}
+
+func (r *CommunicationTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Communication Type dictionary resource. Classifies the contact and member communications, e.g. work or mobile phone.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Communication Type. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Communication Type name.",
+			},
+			"code": schema.StringAttribute{
+				Required:    true,
+				Description: "The Communication Type code. Unique within the domain.",
+			},
+			"channel": schema.StringAttribute{
+				Required:    true,
+				Description: "The Communication Type channel. One of `Phone`, `Email` or `Messaging`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(communicationChannels...),
+				},
+			},
+			"default": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Communication Type is the default one for its channel.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Communication Type.",
+			},
+		},
+	}
+}
+
+func (r *CommunicationTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CommunicationTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data CommunicationTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCommunicationTypeRequest{
+		Name:        data.Name.ValueString(),
+		Code:        data.Code.ValueString(),
+		Channel:     models.NewEngineCommunicationChannels(models.EngineCommunicationChannels(data.Channel.ValueString())),
+		Default:     data.Default.ValueBool(),
+		Description: data.Description.ValueString(),
+	}
+
+	httpResp, err := r.client.CommunicationTypeService.CreateCommunicationTypeWithParams(&communication_type_service.CreateCommunicationTypeParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, communicationTypeToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CommunicationTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data CommunicationTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &communication_type_service.ReadCommunicationTypeParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.CommunicationTypeService.ReadCommunicationType(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, communicationTypeToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CommunicationTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state CommunicationTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &communication_type_service.UpdateCommunicationTypeParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Body: &models.EngineUpdateCommunicationTypeRequest{
+			Name:        plan.Name.ValueString(),
+			Code:        plan.Code.ValueString(),
+			Channel:     models.NewEngineCommunicationChannels(models.EngineCommunicationChannels(plan.Channel.ValueString())),
+			Default:     plan.Default.ValueBool(),
+			Description: plan.Description.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.CommunicationTypeService.UpdateCommunicationTypeWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, communicationTypeToTF(httpResp.GetPayload()))...)
+}
+
+func (r *CommunicationTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data CommunicationTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &communication_type_service.DeleteCommunicationTypeParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.CommunicationTypeService.DeleteCommunicationType(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *CommunicationTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func communicationTypeToTF(in *models.EngineCommunicationType) *CommunicationTypeResourceModel {
+	out := &CommunicationTypeResourceModel{
+		ID:          types.StringValue(in.ID),
+		Name:        types.StringValue(in.Name),
+		Code:        types.StringValue(in.Code),
+		Channel:     types.StringNull(),
+		Default:     types.BoolValue(in.Default),
+		Description: types.StringNull(),
+	}
+
+	if in.Channel != nil {
+		out.Channel = types.StringValue(string(*in.Channel))
+	}
+
+	if in.Description != "" {
+		out.Description = types.StringValue(in.Description)
+	}
+
+	return out
+}
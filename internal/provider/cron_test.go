@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday
+	from := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		expr     string
+		expected time.Time
+		err      bool
+	}{
+		"every-minute": {
+			expr:     "* * * * *",
+			expected: time.Date(2025, time.January, 15, 10, 31, 0, 0, time.UTC),
+		},
+		"nightly": {
+			expr:     "0 2 * * *",
+			expected: time.Date(2025, time.January, 16, 2, 0, 0, 0, time.UTC),
+		},
+		"step": {
+			expr:     "*/20 * * * *",
+			expected: time.Date(2025, time.January, 15, 10, 40, 0, 0, time.UTC),
+		},
+		"range-and-list": {
+			expr:     "0 9-17 * * mon-fri",
+			expected: time.Date(2025, time.January, 15, 11, 0, 0, 0, time.UTC),
+		},
+		"sunday-as-seven": {
+			expr:     "0 0 * * 7",
+			expected: time.Date(2025, time.January, 19, 0, 0, 0, 0, time.UTC),
+		},
+		"month-name": {
+			expr:     "0 0 1 mar *",
+			expected: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"day-of-month-or-week": {
+			expr:     "0 0 20 * mon",
+			expected: time.Date(2025, time.January, 20, 0, 0, 0, 0, time.UTC),
+		},
+		"descriptor": {
+			expr:     "@monthly",
+			expected: time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"leap-day": {
+			expr:     "0 0 29 2 *",
+			expected: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		"never": {
+			expr: "0 0 30 2 *",
+		},
+		"too-few-fields": {
+			expr: "0 2 * *",
+			err:  true,
+		},
+		"out-of-range": {
+			expr: "60 * * * *",
+			err:  true,
+		},
+		"invalid-step": {
+			expr: "*/0 * * * *",
+			err:  true,
+		},
+		"invalid-name": {
+			expr: "0 0 * * funday",
+			err:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := parseCron(testCase.expr)
+			if testCase.err {
+				if err == nil {
+					t.Fatalf("expected error for %q", testCase.expr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := s.next(from); !got.Equal(testCase.expected) {
+				t.Errorf("expected next %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
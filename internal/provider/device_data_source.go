@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/devices"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DeviceDataSource{}
+
+type DeviceDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Account   types.String `tfsdk:"account"`
+	Mac       types.String `tfsdk:"mac"`
+	Hotdesk   types.Bool   `tfsdk:"hotdesk"`
+	Hotdesks  types.Set    `tfsdk:"hotdesks"`
+	Brand     types.String `tfsdk:"brand"`
+	Model     types.String `tfsdk:"model"`
+	IP        types.String `tfsdk:"ip"`
+	UserID    types.String `tfsdk:"user_id"`
+	Provision types.Map    `tfsdk:"provision"`
+}
+
+// DeviceDataSource defines the data source implementation.
+type DeviceDataSource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewDeviceDataSource() datasource.DataSource {
+	return &DeviceDataSource{}
+}
+
+func (d *DeviceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (d *DeviceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the Device by its account or MAC address.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique ID of the Device.",
+			},
+			"account": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The SIP authorization username to look up. Conflicts with `mac`.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("account"), path.MatchRoot("mac")),
+				},
+			},
+			"mac": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The MAC address to look up, regardless of the letter case and separators. Conflicts with `account`.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Device display name.",
+			},
+			"hotdesk": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the Device acts as a hotdesk workstation.",
+			},
+			"hotdesks": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The hotdesk workstation aliases.",
+			},
+			"brand": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Device vendor brand name.",
+			},
+			"model": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Device vendor model name.",
+			},
+			"ip": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Device IP address.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the User the Device is assigned to.",
+			},
+			"provision": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The provisioning template variables of the Device.",
+			},
+		},
+	}
+}
+
+func (d *DeviceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data DeviceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The account filter is a substring match, so page through the results
+	// and match the exact value
+	var found []*models.APIDevice
+	size := int32(100)
+	for page := int32(1); ; page++ {
+		params := &devices.DevicesSearchDeviceParams{
+			Context: ctx,
+			Page:    &page,
+			Size:    &size,
+			Account: data.Account.ValueStringPointer(),
+			Mac:     data.Mac.ValueStringPointer(),
+		}
+
+		httpResp, err := d.client.Devices.DevicesSearchDevice(params)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				"An unexpected error occurred while attempting to read the Devices. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		for _, v := range httpResp.GetPayload().Items {
+			if (!data.Account.IsNull() && v.Account == data.Account.ValueString()) ||
+				(!data.Mac.IsNull() && deviceMACEqual(v.Mac, data.Mac.ValueString())) {
+				found = append(found, v)
+			}
+		}
+
+		if !httpResp.GetPayload().Next {
+			break
+		}
+	}
+
+	if len(found) != 1 {
+		resp.Diagnostics.AddError(
+			"Unable to Find Device",
+			fmt.Sprintf("Expected exactly one Device with account %s or MAC %s, got: %d.", data.Account, data.Mac, len(found)),
+		)
+
+		return
+	}
+
+	// The search may return the short view, so read the Device details
+	readResp, err := d.client.Devices.DevicesReadDevice(&devices.DevicesReadDeviceParams{Context: ctx, ID: found[0].ID})
+	if err != nil || readResp.GetPayload().Device == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			fmt.Sprintf("An unexpected error occurred while attempting to read the Device %s. ", found[0].ID)+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				fmt.Sprintf("HTTP Error: %v", err),
+		)
+
+		return
+	}
+
+	device := deviceToTF(readResp.GetPayload().Device, &DeviceResourceModel{Mac: data.Mac})
+	out := &DeviceDataSourceModel{
+		ID:        device.ID,
+		Name:      device.Name,
+		Account:   device.Account,
+		Mac:       device.Mac,
+		Hotdesk:   device.Hotdesk,
+		Hotdesks:  device.Hotdesks,
+		Brand:     device.Brand,
+		Model:     device.Model,
+		IP:        device.IP,
+		UserID:    device.UserID,
+		Provision: device.Provision,
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
@@ -0,0 +1,405 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/devices"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeviceResource{}
+var _ resource.ResourceWithImportState = &DeviceResource{}
+
+// deviceMACRegexp matches the MAC address written with either colons or
+// dashes as the separator.
+var deviceMACRegexp = regexp.MustCompile(`^([0-9A-Fa-f]{2}[:-]){5}[0-9A-Fa-f]{2}$`)
+
+type DeviceResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Account   types.String `tfsdk:"account"`
+	Password  types.String `tfsdk:"password"`
+	Mac       types.String `tfsdk:"mac"`
+	Hotdesk   types.Bool   `tfsdk:"hotdesk"`
+	Hotdesks  types.Set    `tfsdk:"hotdesks"`
+	Brand     types.String `tfsdk:"brand"`
+	Model     types.String `tfsdk:"model"`
+	IP        types.String `tfsdk:"ip"`
+	UserID    types.String `tfsdk:"user_id"`
+	Provision types.Map    `tfsdk:"provision"`
+}
+
+// DeviceResource defines the resource implementation.
+type DeviceResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewDeviceResource() resource.Resource {
+	return &DeviceResource{}
+}
+
+func (r *DeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device"
+}
+
+func (r *DeviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Device resource. Registers the SIP desk phone or softphone credentials.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Device. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Device display name.",
+			},
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "The SIP authorization username. Unique within the domain.",
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "The SIP authorization password. The password is never read back from the API, " +
+					"so the changes made outside of Terraform are not detected.",
+			},
+			"mac": schema.StringAttribute{
+				Optional:    true,
+				Description: "The hardware MAC address of the desk phone, e.g. `00:15:65:0A:1B:2C`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(deviceMACRegexp, "must be a MAC address"),
+				},
+			},
+			"hotdesk": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Device acts as a hotdesk workstation shared by the Users. Defaults to `false`.",
+			},
+			"hotdesks": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The hotdesk workstation aliases the Users sign in with.",
+			},
+			"brand": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Device vendor brand name, e.g. `Yealink`.",
+			},
+			"model": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Device vendor model name, e.g. `T46U`.",
+			},
+			"ip": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Device IP address.",
+			},
+			"user_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the User the Device is assigned to.",
+			},
+			"provision": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The provisioning template variables of the Device.",
+			},
+		},
+	}
+}
+
+func (r *DeviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data DeviceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device, diags := deviceFromTF(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device.Password = data.Password.ValueString()
+
+	httpResp, err := r.client.Devices.DevicesCreateDeviceWithParams(&devices.DevicesCreateDeviceParams{Context: ctx, Device: device})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) || httpResp.GetPayload().Device == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, deviceToTF(httpResp.GetPayload().Device, &data))...)
+}
+
+func (r *DeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data DeviceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &devices.DevicesReadDeviceParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.Devices.DevicesReadDevice(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Treat the deleted (recycled) Device as gone
+	device := httpResp.GetPayload().Device
+	if device == nil || (device.DeletedAt != "" && device.DeletedAt != "0") {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, deviceToTF(device, &data))...)
+}
+
+func (r *DeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state DeviceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	device, diags := deviceFromTF(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := &models.DevicesUpdateDeviceParamsBody{
+		Name:      device.Name,
+		Account:   device.Account,
+		Mac:       device.Mac,
+		Hotdesk:   device.Hotdesk,
+		Hotdesks:  device.Hotdesks,
+		Brand:     device.Brand,
+		Model:     device.Model,
+		IP:        device.IP,
+		User:      device.User,
+		Provision: device.Provision,
+	}
+
+	// Send the password only when it is changed
+	if !plan.Password.Equal(state.Password) {
+		body.Password = plan.Password.ValueString()
+	}
+
+	params := &devices.DevicesUpdateDeviceParams{
+		Context:  ctx,
+		DeviceID: state.ID.ValueString(),
+		Device:   body,
+	}
+
+	httpResp, err := r.client.Devices.DevicesUpdateDevice(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) || httpResp.GetPayload().Device == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, deviceToTF(httpResp.GetPayload().Device, &plan))...)
+}
+
+func (r *DeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data DeviceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &devices.DevicesDeleteDeviceParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.Devices.DevicesDeleteDevice(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *DeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// deviceMACEqual reports whether both MAC addresses are equal regardless of
+// the letter case and separators.
+func deviceMACEqual(a, b string) bool {
+	normalize := strings.NewReplacer(":", "", "-", "", ".", "")
+
+	return strings.EqualFold(normalize.Replace(a), normalize.Replace(b))
+}
+
+func deviceFromTF(ctx context.Context, data *DeviceResourceModel) (*models.APIDevice, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	out := &models.APIDevice{
+		Name:     data.Name.ValueString(),
+		Account:  data.Account.ValueString(),
+		Mac:      data.Mac.ValueString(),
+		Hotdesk:  data.Hotdesk.ValueBool(),
+		Hotdesks: make([]string, 0),
+		Brand:    data.Brand.ValueString(),
+		Model:    data.Model.ValueString(),
+		IP:       data.IP.ValueString(),
+	}
+
+	if id := data.UserID.ValueString(); id != "" {
+		out.User = &models.APIUserID{ID: id}
+	}
+
+	if !data.Hotdesks.IsNull() {
+		diags.Append(data.Hotdesks.ElementsAs(ctx, &out.Hotdesks, false)...)
+	}
+
+	if !data.Provision.IsNull() {
+		diags.Append(data.Provision.ElementsAs(ctx, &out.Provision, false)...)
+	}
+
+	return out, diags
+}
+
+// deviceToTF converts the Device keeping the password and the MAC address
+// notation of the prior data, as the API never returns the former and may
+// reformat the latter.
+func deviceToTF(in *models.APIDevice, prior *DeviceResourceModel) *DeviceResourceModel {
+	out := &DeviceResourceModel{
+		ID:        types.StringValue(in.ID),
+		Name:      types.StringValue(in.Name),
+		Account:   types.StringValue(in.Account),
+		Password:  prior.Password,
+		Mac:       stringOrNull(in.Mac),
+		Hotdesk:   types.BoolValue(in.Hotdesk),
+		Hotdesks:  types.SetNull(types.StringType),
+		Brand:     stringOrNull(in.Brand),
+		Model:     stringOrNull(in.Model),
+		IP:        stringOrNull(in.IP),
+		UserID:    types.StringNull(),
+		Provision: stringMapToTF(in.Provision),
+	}
+
+	if in.User != nil && in.User.ID != "" {
+		out.UserID = types.StringValue(in.User.ID)
+	}
+
+	if len(in.Hotdesks) != 0 {
+		out.Hotdesks = stringsToSet(in.Hotdesks)
+	}
+
+	if deviceMACEqual(prior.Mac.ValueString(), in.Mac) {
+		out.Mac = prior.Mac
+	}
+
+	return out
+}
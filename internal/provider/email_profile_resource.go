@@ -0,0 +1,485 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/email_profile_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// emailAuthTypes are the authentication types supported by the Email Profiles.
+var emailAuthTypes = []string{
+	string(models.EngineEmailAuthTypePlain),
+	string(models.EngineEmailAuthTypeOAuth2),
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EmailProfileResource{}
+var _ resource.ResourceWithImportState = &EmailProfileResource{}
+
+type EmailProfileResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Listen        types.Bool   `tfsdk:"listen"`
+	AuthType      types.String `tfsdk:"auth_type"`
+	Login         types.String `tfsdk:"login"`
+	Password      types.String `tfsdk:"password"`
+	Mailbox       types.String `tfsdk:"mailbox"`
+	ImapHost      types.String `tfsdk:"imap_host"`
+	ImapPort      types.Int64  `tfsdk:"imap_port"`
+	SMTPHost      types.String `tfsdk:"smtp_host"`
+	SMTPPort      types.Int64  `tfsdk:"smtp_port"`
+	FetchInterval types.Int64  `tfsdk:"fetch_interval"`
+	SchemaID      types.String `tfsdk:"schema_id"`
+	OAuth2        types.Object `tfsdk:"oauth2"`
+}
+
+type EmailProfileOAuth2 struct {
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	RedirectURL  types.String `tfsdk:"redirect_url"`
+}
+
+// EmailProfileResource defines the resource implementation.
+type EmailProfileResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewEmailProfileResource() resource.Resource {
+	return &EmailProfileResource{}
+}
+
+func (r *EmailProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_profile"
+}
+
+func emailProfileOAuth2Schema() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"client_id":     types.StringType,
+			"client_secret": types.StringType,
+			"redirect_url":  types.StringType,
+		},
+	}
+}
+
+func (r *EmailProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	portValidators := []validator.Int64{
+		int64validator.Between(1, 65535),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "The Email Profile resource. Connects the mailbox to the email channel and " +
+			"routes the incoming emails to the flow schema.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Email Profile. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Email Profile name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Email Profile.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Email Profile fetches the emails. Defaults to `false`.",
+			},
+			"listen": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the mailbox is watched with IMAP IDLE instead of polling. Defaults to `false`.",
+			},
+			"auth_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(models.EngineEmailAuthTypePlain)),
+				Description: "The mailbox authentication type. One of `Plain` or `OAuth2`. Defaults to `Plain`. " +
+					"The `OAuth2` profiles require the one-time sign-in in the Webitel admin after the creation.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(emailAuthTypes...),
+				},
+			},
+			"login": schema.StringAttribute{
+				Required:    true,
+				Description: "The mailbox login.",
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "The mailbox password for the `Plain` authentication. The password is never read back " +
+					"from the API, so the changes made outside of Terraform are not detected.",
+			},
+			"mailbox": schema.StringAttribute{
+				Required:    true,
+				Description: "The mailbox (IMAP folder) to fetch the emails from, e.g. `INBOX`.",
+			},
+			"imap_host": schema.StringAttribute{
+				Required:    true,
+				Description: "The IMAP server host name.",
+			},
+			"imap_port": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Description:   "The IMAP server port, e.g. `993`.",
+				Validators:    portValidators,
+			},
+			"smtp_host": schema.StringAttribute{
+				Required:    true,
+				Description: "The SMTP server host name.",
+			},
+			"smtp_port": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Description:   "The SMTP server port, e.g. `587`.",
+				Validators:    portValidators,
+			},
+			"fetch_interval": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Description:   "The mailbox polling interval in seconds.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"schema_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the flow Routing Schema processing the incoming emails.",
+			},
+			"oauth2": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The OAuth2 application parameters for the `OAuth2` authentication, e.g. Microsoft 365 or Gmail.",
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The OAuth2 application (client) ID.",
+					},
+					"client_secret": schema.StringAttribute{
+						Required:    true,
+						Sensitive:   true,
+						Description: "The OAuth2 application secret. Never read back from the API.",
+					},
+					"redirect_url": schema.StringAttribute{
+						Required:    true,
+						Description: "The OAuth2 redirect URL registered for the application.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *EmailProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EmailProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data EmailProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := emailProfileParams(ctx, data.OAuth2)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreateEmailProfileRequest{
+		Name:          data.Name.ValueString(),
+		Description:   data.Description.ValueString(),
+		Enabled:       data.Enabled.ValueBool(),
+		Listen:        data.Listen.ValueBool(),
+		AuthType:      models.NewEngineEmailAuthType(models.EngineEmailAuthType(data.AuthType.ValueString())),
+		Login:         data.Login.ValueString(),
+		Password:      data.Password.ValueString(),
+		Mailbox:       data.Mailbox.ValueString(),
+		ImapHost:      data.ImapHost.ValueString(),
+		ImapPort:      int32(data.ImapPort.ValueInt64()),
+		SMTPHost:      data.SMTPHost.ValueString(),
+		SMTPPort:      int32(data.SMTPPort.ValueInt64()),
+		FetchInterval: int32(data.FetchInterval.ValueInt64()),
+		Schema:        lookupOrNil(data.SchemaID),
+		Params:        params,
+	}
+
+	httpResp, err := r.client.EmailProfileService.CreateEmailProfileWithParams(&email_profile_service.CreateEmailProfileParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, emailProfileToTF(ctx, httpResp.GetPayload(), &data))...)
+}
+
+func (r *EmailProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data EmailProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &email_profile_service.ReadEmailProfileParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.EmailProfileService.ReadEmailProfileWithParams(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, emailProfileToTF(ctx, httpResp.GetPayload(), &data))...)
+}
+
+func (r *EmailProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state EmailProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := emailProfileParams(ctx, plan.OAuth2)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := &models.EngineUpdateEmailProfileRequest{
+		Name:          plan.Name.ValueString(),
+		Description:   plan.Description.ValueString(),
+		Enabled:       plan.Enabled.ValueBool(),
+		Listen:        plan.Listen.ValueBool(),
+		AuthType:      models.NewEngineEmailAuthType(models.EngineEmailAuthType(plan.AuthType.ValueString())),
+		Login:         plan.Login.ValueString(),
+		Mailbox:       plan.Mailbox.ValueString(),
+		ImapHost:      plan.ImapHost.ValueString(),
+		ImapPort:      int32(plan.ImapPort.ValueInt64()),
+		SMTPHost:      plan.SMTPHost.ValueString(),
+		SMTPPort:      int32(plan.SMTPPort.ValueInt64()),
+		FetchInterval: int32(plan.FetchInterval.ValueInt64()),
+		Schema:        lookupOrNil(plan.SchemaID),
+		Params:        params,
+	}
+
+	// Send the password only when it is changed
+	if !plan.Password.Equal(state.Password) {
+		body.Password = plan.Password.ValueString()
+	}
+
+	input := &email_profile_service.UpdateEmailProfileParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Body:    body,
+	}
+
+	httpResp, err := r.client.EmailProfileService.UpdateEmailProfileWithParams(input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, emailProfileToTF(ctx, httpResp.GetPayload(), &plan))...)
+}
+
+func (r *EmailProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data EmailProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &email_profile_service.DeleteEmailProfileParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.EmailProfileService.DeleteEmailProfileWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *EmailProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// emailProfileParams returns the Email Profile params. A null oauth2 block
+// gives empty params, so the update removes the OAuth2 client.
+func emailProfileParams(ctx context.Context, o types.Object) (*models.EngineEmailProfileParams, diag.Diagnostics) {
+	if o.IsNull() || o.IsUnknown() {
+		return &models.EngineEmailProfileParams{}, nil
+	}
+
+	var oauth2 EmailProfileOAuth2
+	diags := o.As(ctx, &oauth2, basetypes.ObjectAsOptions{})
+
+	return &models.EngineEmailProfileParams{
+		Oauth2: &models.EmailProfileParamsOAuth2{
+			ClientID:     oauth2.ClientID.ValueString(),
+			ClientSecret: oauth2.ClientSecret.ValueString(),
+			RedirectURL:  oauth2.RedirectURL.ValueString(),
+		},
+	}, diags
+}
+
+// emailProfileToTF converts the Email Profile keeping the secrets of the
+// prior data, as the API never returns them.
+func emailProfileToTF(ctx context.Context, in *models.EngineEmailProfile, prior *EmailProfileResourceModel) *EmailProfileResourceModel {
+	out := &EmailProfileResourceModel{
+		ID:            types.StringValue(in.ID),
+		Name:          types.StringValue(in.Name),
+		Description:   stringOrNull(in.Description),
+		Enabled:       types.BoolValue(in.Enabled),
+		Listen:        types.BoolValue(in.Listen),
+		AuthType:      types.StringValue(string(models.EngineEmailAuthTypePlain)),
+		Login:         types.StringValue(in.Login),
+		Password:      prior.Password,
+		Mailbox:       types.StringValue(in.Mailbox),
+		ImapHost:      types.StringValue(in.ImapHost),
+		ImapPort:      types.Int64Value(int64(in.ImapPort)),
+		SMTPHost:      types.StringValue(in.SMTPHost),
+		SMTPPort:      types.Int64Value(int64(in.SMTPPort)),
+		FetchInterval: types.Int64Value(int64(in.FetchInterval)),
+		SchemaID:      lookupToTF(in.Schema),
+		OAuth2:        types.ObjectNull(emailProfileOAuth2Schema().AttributeTypes()),
+	}
+
+	if in.AuthType != nil && *in.AuthType != models.EngineEmailAuthTypeEmailAuthTypeUndefined {
+		out.AuthType = types.StringValue(string(*in.AuthType))
+	}
+
+	if in.Params != nil && in.Params.Oauth2 != nil && in.Params.Oauth2.ClientID != "" {
+		secret := types.StringValue(in.Params.Oauth2.ClientSecret)
+		if !prior.OAuth2.IsNull() && !prior.OAuth2.IsUnknown() {
+			var oauth2 EmailProfileOAuth2
+			if diags := prior.OAuth2.As(ctx, &oauth2, basetypes.ObjectAsOptions{}); !diags.HasError() {
+				secret = oauth2.ClientSecret
+			}
+		}
+
+		out.OAuth2 = types.ObjectValueMust(emailProfileOAuth2Schema().AttributeTypes(), map[string]attr.Value{
+			"client_id":     types.StringValue(in.Params.Oauth2.ClientID),
+			"client_secret": secret,
+			"redirect_url":  types.StringValue(in.Params.Oauth2.RedirectURL),
+		})
+	}
+
+	return out
+}
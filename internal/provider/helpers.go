@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// millisecondsRegexp matches the durations and timestamps in milliseconds.
+var millisecondsRegexp = regexp.MustCompile(`^\d+$`)
+
+// isNotFound reports whether err is an API response with HTTP 404 Not Found status.
+// Both runtime.APIError and generated default responses implement IsCode.
+func isNotFound(err error) bool {
+	var codeErr interface{ IsCode(code int) bool }
+	if errors.As(err, &codeErr) {
+		return codeErr.IsCode(http.StatusNotFound)
+	}
+
+	return false
+}
+
+// importStateCompositeID splits the import identifier by "/" and stores
+// each part into the corresponding root attribute, e.g. "<queue_id>/<id>".
+func importStateCompositeID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attrs ...string) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != len(attrs) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", strings.Join(attrs, "/"), req.ID),
+		)
+
+		return
+	}
+
+	for i, name := range attrs {
+		if parts[i] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: %s. Got empty %s in %q", strings.Join(attrs, "/"), name, req.ID),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), parts[i])...)
+	}
+}
+
+// int32ID parses the resource ID of the APIs using int32 identifiers.
+func int32ID(id types.String) (int32, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	v, err := strconv.ParseInt(id.ValueString(), 10, 32)
+	if err != nil {
+		diags.AddError("Unable to parse id", err.Error())
+	}
+
+	return int32(v), diags
+}
+
+// stringsToList converts the strings into a list value keeping their order.
+func stringsToList(in []string) types.List {
+	elements := make([]attr.Value, 0, len(in))
+	for _, v := range in {
+		elements = append(elements, types.StringValue(v))
+	}
+
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// stringsToSet converts the strings into a set value.
+func stringsToSet(in []string) types.Set {
+	elements := make([]attr.Value, 0, len(in))
+	for _, v := range in {
+		elements = append(elements, types.StringValue(v))
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// lookupOrNil returns the lookup referencing the id or nil when the id is not set.
+func lookupOrNil(id types.String) *models.EngineLookup {
+	if id.IsNull() || id.IsUnknown() || id.ValueString() == "" {
+		return nil
+	}
+
+	return &models.EngineLookup{ID: id.ValueString()}
+}
+
+// lookupToTF returns the id of the lookup or null when the lookup is empty.
+func lookupToTF(in *models.EngineLookup) types.String {
+	if in == nil || in.ID == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(in.ID)
+}
+
+// lookups returns the lookups referencing the set of ids. A null or unknown
+// set gives an empty slice, so the update clears the references.
+func lookups(ctx context.Context, ids types.Set) ([]*models.EngineLookup, diag.Diagnostics) {
+	if ids.IsNull() || ids.IsUnknown() {
+		return []*models.EngineLookup{}, nil
+	}
+
+	var values []string
+	diags := ids.ElementsAs(ctx, &values, false)
+
+	out := make([]*models.EngineLookup, 0, len(values))
+	for _, v := range values {
+		out = append(out, &models.EngineLookup{ID: v})
+	}
+
+	return out, diags
+}
+
+// lookupsToTF returns the set of the lookup ids or null when there are none.
+func lookupsToTF(in []*models.EngineLookup) types.Set {
+	if len(in) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	ids := make([]string, 0, len(in))
+	for _, v := range in {
+		ids = append(ids, v.ID)
+	}
+
+	return stringsToSet(ids)
+}
+
+// stringOrNull returns the string value or null when the string is empty.
+func stringOrNull(in string) types.String {
+	if in == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(in)
+}
+
+// millisecondsToTF returns the timestamp in milliseconds or null when it is not set.
+func millisecondsToTF(in string) types.String {
+	if in == "" || in == "0" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(in)
+}
+
+// stringMapToTF converts the map into a map value or null when the map is empty.
+func stringMapToTF(in map[string]string) types.Map {
+	if len(in) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := make(map[string]attr.Value, len(in))
+	for k, v := range in {
+		elements[k] = types.StringValue(v)
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// generalLookupOrNil returns the lookup referencing the id or nil when the id is not set.
+func generalLookupOrNil(id types.String) *models.GeneralLookup {
+	if id.IsNull() || id.IsUnknown() || id.ValueString() == "" {
+		return nil
+	}
+
+	return &models.GeneralLookup{ID: id.ValueString()}
+}
+
+// generalLookupToTF returns the id of the lookup or null when the lookup is empty.
+func generalLookupToTF(in *models.GeneralLookup) types.String {
+	if in == nil || in.ID == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(in.ID)
+}
+
+// generalLookups returns the lookups referencing the set of ids.
+func generalLookups(ctx context.Context, ids types.Set) ([]*models.GeneralLookup, diag.Diagnostics) {
+	var values []string
+	diags := ids.ElementsAs(ctx, &values, false)
+
+	out := make([]*models.GeneralLookup, 0, len(values))
+	for _, v := range values {
+		out = append(out, &models.GeneralLookup{ID: v})
+	}
+
+	return out, diags
+}
+
+// generalLookupsToTF returns the set of the lookup ids or null when there are none.
+func generalLookupsToTF(in []*models.GeneralLookup) types.Set {
+	if len(in) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	ids := make([]string, 0, len(in))
+	for _, v := range in {
+		ids = append(ids, v.ID)
+	}
+
+	return stringsToSet(ids)
+}
+
+// wfmLookupOrNil returns the lookup referencing the id or nil when the id is not set.
+func wfmLookupOrNil(id types.String) *models.WfmLookupEntity {
+	if id.IsNull() || id.IsUnknown() || id.ValueString() == "" {
+		return nil
+	}
+
+	return &models.WfmLookupEntity{ID: id.ValueString()}
+}
+
+// wfmLookupToTF returns the id of the lookup or null when the lookup is empty.
+func wfmLookupToTF(in *models.WfmLookupEntity) types.String {
+	if in == nil || in.ID == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(in.ID)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/webitel/webitel-openapi-client-go/client/bucket_service"
+)
+
+func TestIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"api-error-not-found": {
+			err:      runtime.NewAPIError("not found", nil, http.StatusNotFound),
+			expected: true,
+		},
+		"api-error-other": {
+			err:      runtime.NewAPIError("bad request", nil, http.StatusBadRequest),
+			expected: false,
+		},
+		"default-response-not-found": {
+			err:      bucket_service.NewReadBucketDefault(http.StatusNotFound),
+			expected: true,
+		},
+		"wrapped-default-response-not-found": {
+			err:      fmt.Errorf("read: %w", bucket_service.NewReadBucketDefault(http.StatusNotFound)),
+			expected: true,
+		},
+		"plain-error": {
+			err:      errors.New("connection refused"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isNotFound(testCase.err); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestLookups(t *testing.T) {
+	t.Parallel()
+
+	// The null set clears the references instead of omitting them
+	for _, ids := range []types.Set{types.SetNull(types.StringType), types.SetUnknown(types.StringType)} {
+		out, diags := lookups(context.Background(), ids)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		if out == nil || len(out) != 0 {
+			t.Errorf("expected an empty slice for %s, got %v", ids, out)
+		}
+	}
+
+	ids := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("1")})
+	out, diags := lookups(context.Background(), ids)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(out) != 1 || out[0].ID != "1" {
+		t.Errorf("expected the lookup of 1, got %v", out)
+	}
+}
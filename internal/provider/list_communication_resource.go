@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/list_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ListCommunicationResource{}
+var _ resource.ResourceWithImportState = &ListCommunicationResource{}
+
+type ListCommunicationResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ListID      types.String `tfsdk:"list_id"`
+	Number      types.String `tfsdk:"number"`
+	Description types.String `tfsdk:"description"`
+	ExpireAt    types.String `tfsdk:"expire_at"`
+}
+
+// ListCommunicationResource defines the resource implementation.
+type ListCommunicationResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewListCommunicationResource() resource.Resource {
+	return &ListCommunicationResource{}
+}
+
+func (r *ListCommunicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_list_communication"
+}
+
+func (r *ListCommunicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The List communication resource. A single number of the List.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the List communication. Never changes.",
+			},
+			"list_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The List ID. Changing this forces a new resource to be created.",
+			},
+			"number": schema.StringAttribute{
+				Required:    true,
+				Description: "The communication number.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the List communication.",
+			},
+			"expire_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "The expiration timestamp in milliseconds since Unix epoch. The number is removed from the List after it.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a timestamp in milliseconds"),
+				},
+			},
+		},
+	}
+}
+
+func (r *ListCommunicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ListCommunicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data ListCommunicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &list_service.CreateListCommunicationParams{
+		Context: ctx,
+		ListID:  data.ListID.ValueString(),
+		Body: &models.EngineCreateListCommunicationRequest{
+			Number:      data.Number.ValueString(),
+			Description: data.Description.ValueString(),
+			ExpireAt:    data.ExpireAt.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.ListService.CreateListCommunicationWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, listCommunicationToTF(data.ListID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *ListCommunicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data ListCommunicationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &list_service.ReadListCommunicationParams{
+		Context: ctx,
+		ListID:  data.ListID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.ListService.ReadListCommunication(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, listCommunicationToTF(data.ListID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *ListCommunicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state ListCommunicationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &list_service.UpdateListCommunicationParams{
+		Context: ctx,
+		ListID:  state.ListID.ValueString(),
+		ID:      state.ID.ValueString(),
+		Body: &models.EngineUpdateListCommunicationRequest{
+			Number:      plan.Number.ValueString(),
+			Description: plan.Description.ValueString(),
+			ExpireAt:    plan.ExpireAt.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.ListService.UpdateListCommunication(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, listCommunicationToTF(state.ListID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *ListCommunicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data ListCommunicationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &list_service.DeleteListCommunicationParams{
+		Context: ctx,
+		ListID:  data.ListID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.ListService.DeleteListCommunication(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *ListCommunicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "list_id", "id")
+}
+
+func listCommunicationToTF(listID string, in *models.EngineListCommunication) *ListCommunicationResourceModel {
+	out := &ListCommunicationResourceModel{
+		ID:          types.StringValue(in.ID),
+		ListID:      types.StringValue(listID),
+		Number:      types.StringValue(in.Number),
+		Description: types.StringNull(),
+		ExpireAt:    types.StringNull(),
+	}
+
+	if in.Description != "" {
+		out.Description = types.StringValue(in.Description)
+	}
+
+	if in.ExpireAt != "" && in.ExpireAt != "0" {
+		out.ExpireAt = types.StringValue(in.ExpireAt)
+	}
+
+	return out
+}
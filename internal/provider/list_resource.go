@@ -0,0 +1,435 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/list_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// listNumbersPageSize is the page size used to fetch the list communications.
+const listNumbersPageSize = 1000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ListResource{}
+var _ resource.ResourceWithImportState = &ListResource{}
+
+type ListResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Numbers     types.Set    `tfsdk:"numbers"`
+}
+
+// ListResource defines the resource implementation.
+type ListResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewListResource() resource.Resource {
+	return &ListResource{}
+}
+
+func (r *ListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_list"
+}
+
+func (r *ListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The List resource, e.g. the do-not-call list. " +
+			"The List communications are skipped by the dialer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the List. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The List name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the List.",
+			},
+			"numbers": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The complete set of the List numbers. When set, the List communications " +
+					"are managed by this attribute: only the added numbers are created and only the " +
+					"removed numbers are deleted on update. Do not combine with `webitel_list_communication` " +
+					"resources for the same List.",
+			},
+		},
+	}
+}
+
+func (r *ListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data ListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreateListRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}
+
+	httpResp, err := r.client.ListService.CreateListWithParams(&list_service.CreateListParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	out := listToTF(httpResp.GetPayload())
+	out.Numbers = data.Numbers
+
+	// Save the List before adding the numbers, so a partial failure
+	// does not leave the List out of the Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+	if resp.Diagnostics.HasError() || data.Numbers.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncNumbers(ctx, out.ID.ValueString(), data.Numbers)...)
+}
+
+func (r *ListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data ListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &list_service.ReadListParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.ListService.ReadList(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	out := listToTF(httpResp.GetPayload())
+	out.Numbers = data.Numbers
+
+	// Refresh the numbers only when they are managed by the resource
+	if !data.Numbers.IsNull() {
+		numbers, err := r.listNumbers(ctx, out.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Refresh Resource",
+				"An unexpected error occurred while attempting to refresh the List numbers. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		values := make([]string, 0, len(numbers))
+		for number := range numbers {
+			values = append(values, number)
+		}
+
+		sort.Strings(values)
+		out.Numbers = stringsToSet(values)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *ListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state ListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out := &state
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		params := &list_service.UpdateListParams{
+			Context: ctx,
+			ID:      state.ID.ValueString(),
+			Body: &models.EngineUpdateListRequest{
+				Name:        plan.Name.ValueString(),
+				Description: plan.Description.ValueString(),
+			},
+		}
+
+		httpResp, err := r.client.ListService.UpdateListWithParams(params)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				"An unexpected error occurred while attempting to update the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		// Return error if the HTTP status code is not 200 OK
+		if !httpResp.IsCode(http.StatusOK) {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				"An unexpected error occurred while attempting to update the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+			)
+
+			return
+		}
+
+		out = listToTF(httpResp.GetPayload())
+	}
+
+	out.Numbers = plan.Numbers
+	if !plan.Numbers.IsNull() && !plan.Numbers.Equal(state.Numbers) {
+		resp.Diagnostics.Append(r.syncNumbers(ctx, state.ID.ValueString(), plan.Numbers)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *ListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data ListResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &list_service.DeleteListParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.ListService.DeleteList(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *ListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// listNumbers fetches all the List communications and returns their IDs keyed by number.
+func (r *ListResource) listNumbers(ctx context.Context, listID string) (map[string]string, error) {
+	out := make(map[string]string)
+	for page := int32(1); ; page++ {
+		size := int32(listNumbersPageSize)
+		params := &list_service.SearchListCommunicationParams{
+			Context: ctx,
+			ListID:  listID,
+			Page:    &page,
+			Size:    &size,
+			Fields:  []string{"id", "number"},
+		}
+
+		httpResp, err := r.client.ListService.SearchListCommunication(params)
+		if err != nil {
+			return nil, err
+		}
+
+		payload := httpResp.GetPayload()
+		for _, v := range payload.Items {
+			out[v.Number] = v.ID
+		}
+
+		if !payload.Next || len(payload.Items) == 0 {
+			return out, nil
+		}
+	}
+}
+
+// syncNumbers brings the List communications to the desired set of numbers.
+// Only the difference with the numbers stored in the List is sent to the API.
+func (r *ListResource) syncNumbers(ctx context.Context, listID string, numbers types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var desired []string
+	diags.Append(numbers.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := r.listNumbers(ctx, listID)
+	if err != nil {
+		diags.AddError(
+			"Unable to Update List Numbers",
+			"An unexpected error occurred while attempting to read the List numbers. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	add, remove := listNumbersDiff(current, desired)
+	for _, id := range remove {
+		params := &list_service.DeleteListCommunicationParams{
+			Context: ctx,
+			ListID:  listID,
+			ID:      id,
+		}
+
+		if _, err := r.client.ListService.DeleteListCommunication(params); err != nil && !isNotFound(err) {
+			diags.AddError(
+				"Unable to Update List Numbers",
+				"An unexpected error occurred while attempting to remove the List number. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return diags
+		}
+	}
+
+	for _, number := range add {
+		params := &list_service.CreateListCommunicationParams{
+			Context: ctx,
+			ListID:  listID,
+			Body: &models.EngineCreateListCommunicationRequest{
+				Number: number,
+			},
+		}
+
+		if _, err := r.client.ListService.CreateListCommunicationWithParams(params); err != nil {
+			diags.AddError(
+				"Unable to Update List Numbers",
+				fmt.Sprintf("An unexpected error occurred while attempting to add the List number %q. ", number)+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// listNumbersDiff returns the numbers to be added and the communication IDs
+// to be removed to turn the current List numbers into the desired ones.
+func listNumbersDiff(current map[string]string, desired []string) (add []string, remove []string) {
+	keep := make(map[string]bool, len(desired))
+	for _, number := range desired {
+		keep[number] = true
+		if _, ok := current[number]; !ok {
+			add = append(add, number)
+		}
+	}
+
+	for number, id := range current {
+		if !keep[number] {
+			remove = append(remove, id)
+		}
+	}
+
+	sort.Strings(add)
+	sort.Strings(remove)
+
+	return add, remove
+}
+
+func listToTF(in *models.EngineList) *ListResourceModel {
+	out := &ListResourceModel{
+		ID:          types.StringValue(in.ID),
+		Name:        types.StringValue(in.Name),
+		Description: types.StringNull(),
+		Numbers:     types.SetNull(types.StringType),
+	}
+
+	if in.Description != "" {
+		out.Description = types.StringValue(in.Description)
+	}
+
+	return out
+}
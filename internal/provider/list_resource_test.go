@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListNumbersDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current        map[string]string
+		desired        []string
+		expectedAdd    []string
+		expectedRemove []string
+	}{
+		"empty": {
+			current: map[string]string{},
+			desired: nil,
+		},
+		"add-all": {
+			current:     map[string]string{},
+			desired:     []string{"380442", "380441"},
+			expectedAdd: []string{"380441", "380442"},
+		},
+		"remove-all": {
+			current:        map[string]string{"380441": "1", "380442": "2"},
+			desired:        []string{},
+			expectedRemove: []string{"1", "2"},
+		},
+		"unchanged": {
+			current: map[string]string{"380441": "1", "380442": "2"},
+			desired: []string{"380442", "380441"},
+		},
+		"add-and-remove": {
+			current:        map[string]string{"380441": "1", "380442": "2"},
+			desired:        []string{"380442", "380443"},
+			expectedAdd:    []string{"380443"},
+			expectedRemove: []string{"1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			add, remove := listNumbersDiff(testCase.current, testCase.desired)
+			if !reflect.DeepEqual(add, testCase.expectedAdd) {
+				t.Errorf("expected add %v, got %v", testCase.expectedAdd, add)
+			}
+
+			if !reflect.DeepEqual(remove, testCase.expectedRemove) {
+				t.Errorf("expected remove %v, got %v", testCase.expectedRemove, remove)
+			}
+		})
+	}
+}
@@ -0,0 +1,420 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/media_file_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MediaFileResource{}
+var _ resource.ResourceWithImportState = &MediaFileResource{}
+var _ resource.ResourceWithModifyPlan = &MediaFileResource{}
+
+type MediaFileResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Source      types.String `tfsdk:"source"`
+	Content     types.String `tfsdk:"content"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	MimeType    types.String `tfsdk:"mime_type"`
+	Size        types.Int64  `tfsdk:"size"`
+}
+
+// MediaFileResource defines the resource implementation.
+type MediaFileResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewMediaFileResource() resource.Resource {
+	return &MediaFileResource{}
+}
+
+func (r *MediaFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_media_file"
+}
+
+func (r *MediaFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Media File resource. Uploads the audio file, e.g. IVR prompt or hold music, " +
+			"to the storage. The media files can not be modified, so any content change replaces the file.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Media File. Used to play the file in the routing schemas.",
+			},
+			"name": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The Media File name including the extension, e.g. `welcome.wav`. Unique within the domain.",
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path to the local file to upload. Conflicts with `content`.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("source"), path.MatchRoot("content")),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Description: "The base64-encoded file content to upload. Conflicts with `source`.",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed: true,
+				Description: "The SHA-256 hash of the uploaded content. The file is uploaded again " +
+					"whenever the hash of `source` or `content` changes.",
+			},
+			"mime_type": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The MIME type detected by the storage, e.g. `audio/wav`.",
+			},
+			"size": schema.Int64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Description:   "The file size in bytes.",
+			},
+		},
+	}
+}
+
+func (r *MediaFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan computes the content hash at plan time, so the changes of the
+// local file are detected even if the source path stays the same.
+func (r *MediaFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to upload on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan MediaFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Leave the hash unknown until the content is known
+	if plan.Source.IsUnknown() || plan.Content.IsUnknown() {
+		return
+	}
+
+	content, err := mediaFileContent(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Media File Content",
+			"An error occurred while attempting to read the media file content.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	hash := mediaFileHash(content)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), hash)...)
+
+	// The state is null on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state MediaFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The imported file has no hash yet, so adopt the configured content
+	if !state.ContentHash.IsNull() && state.ContentHash.ValueString() != hash {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+	}
+}
+
+func (r *MediaFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data MediaFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := mediaFileContent(&data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Media File Content",
+			"An error occurred while attempting to read the media file content.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if err := r.upload(ctx, data.Name.ValueString(), content); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// The upload response does not follow the API models, so look up the
+	// uploaded file by its unique name
+	file, err := r.findByName(ctx, data.Name.ValueString())
+	if err != nil || file == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			fmt.Sprintf("The Media File %s was uploaded, but could not be found. ", data.Name)+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				fmt.Sprintf("HTTP Error: %v", err),
+		)
+
+		return
+	}
+
+	data.ContentHash = types.StringValue(mediaFileHash(content))
+	mediaFileToTF(file, &data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MediaFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data MediaFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &media_file_service.ReadMediaFileParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.MediaFileService.ReadMediaFile(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if httpResp.GetPayload() == nil || httpResp.GetPayload().ID == "" {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	mediaFileToTF(httpResp.GetPayload(), &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MediaFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state MediaFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the way the same content is provided may change in place, e.g.
+	// the file is moved or inlined, so there is nothing to send
+	if plan.ContentHash.IsUnknown() {
+		content, err := mediaFileContent(&plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Media File Content",
+				"An error occurred while attempting to read the media file content.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		plan.ContentHash = types.StringValue(mediaFileHash(content))
+	}
+
+	plan.ID = state.ID
+	plan.MimeType = state.MimeType
+	plan.Size = state.Size
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *MediaFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data MediaFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &media_file_service.DeleteMediaFileParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.MediaFileService.DeleteMediaFile(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *MediaFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// upload posts the file as the multipart form, as the generated client
+// does not cover the media upload operation.
+func (r *MediaFileResource) upload(ctx context.Context, name string, content []byte) error {
+	op := &runtime.ClientOperation{
+		ID:                 "UploadMediaFile",
+		Method:             "POST",
+		PathPattern:        "/storage/media",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"multipart/form-data"},
+		Schemes:            []string{"https"},
+		Params: runtime.ClientRequestWriterFunc(func(req runtime.ClientRequest, _ strfmt.Registry) error {
+			return req.SetFileParam("file", runtime.NamedReader(name, bytes.NewReader(content)))
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, _ runtime.Consumer) (interface{}, error) {
+			if resp.Code() != http.StatusOK && resp.Code() != http.StatusCreated {
+				body, _ := io.ReadAll(resp.Body())
+
+				return nil, runtime.NewAPIError("UploadMediaFile", string(body), resp.Code())
+			}
+
+			return nil, nil
+		}),
+		Context: ctx,
+	}
+
+	_, err := r.client.Transport.Submit(op)
+
+	return err
+}
+
+// findByName returns the Media File with exactly the given name, or nil.
+func (r *MediaFileResource) findByName(ctx context.Context, name string) (*models.StorageMediaFile, error) {
+	size := int32(100)
+	for page := int32(1); ; page++ {
+		params := &media_file_service.SearchMediaFileParams{
+			Context: ctx,
+			Page:    &page,
+			Size:    &size,
+			Q:       &name,
+		}
+
+		httpResp, err := r.client.MediaFileService.SearchMediaFile(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range httpResp.GetPayload().Items {
+			if v.Name == name {
+				return v, nil
+			}
+		}
+
+		if !httpResp.GetPayload().Next {
+			return nil, nil
+		}
+	}
+}
+
+// mediaFileContent returns the content to upload from either the local
+// source file or the base64-encoded content.
+func mediaFileContent(data *MediaFileResourceModel) ([]byte, error) {
+	if !data.Source.IsNull() {
+		return os.ReadFile(data.Source.ValueString())
+	}
+
+	return base64.StdEncoding.DecodeString(data.Content.ValueString())
+}
+
+func mediaFileHash(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func mediaFileToTF(in *models.StorageMediaFile, out *MediaFileResourceModel) {
+	out.ID = types.StringValue(in.ID)
+	out.Name = types.StringValue(in.Name)
+	out.MimeType = types.StringValue(in.MimeType)
+	out.Size = types.Int64Null()
+
+	if size, err := strconv.ParseInt(in.Size, 10, 64); err == nil {
+		out.Size = types.Int64Value(size)
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/outbound_resource_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OutboundResourceDisplayResource{}
+var _ resource.ResourceWithImportState = &OutboundResourceDisplayResource{}
+
+type OutboundResourceDisplayResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ResourceID types.String `tfsdk:"resource_id"`
+	Display    types.String `tfsdk:"display"`
+}
+
+// OutboundResourceDisplayResource defines the resource implementation.
+type OutboundResourceDisplayResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewOutboundResourceDisplayResource() resource.Resource {
+	return &OutboundResourceDisplayResource{}
+}
+
+func (r *OutboundResourceDisplayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_outbound_resource_display"
+}
+
+func (r *OutboundResourceDisplayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Outbound Resource display number. The dialer picks the caller ID " +
+			"of outbound calls from the display numbers of the Outbound Resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the display number. Never changes.",
+			},
+			"resource_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The Outbound Resource ID. Changing this forces a new resource to be created.",
+			},
+			"display": schema.StringAttribute{
+				Required:    true,
+				Description: "The caller ID (number) presented to the callee.",
+			},
+		},
+	}
+}
+
+func (r *OutboundResourceDisplayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OutboundResourceDisplayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data OutboundResourceDisplayResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &outbound_resource_service.CreateOutboundResourceDisplayParams{
+		Context:    ctx,
+		ResourceID: data.ResourceID.ValueString(),
+		Body: &models.EngineCreateOutboundResourceDisplayRequest{
+			Display: data.Display.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.OutboundResourceService.CreateOutboundResourceDisplayWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceDisplayToTF(data.ResourceID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceDisplayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data OutboundResourceDisplayResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &outbound_resource_service.ReadOutboundResourceDisplayParams{
+		Context:    ctx,
+		ResourceID: data.ResourceID.ValueString(),
+		ID:         data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.OutboundResourceService.ReadOutboundResourceDisplay(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceDisplayToTF(data.ResourceID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceDisplayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state OutboundResourceDisplayResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &outbound_resource_service.UpdateOutboundResourceDisplayParams{
+		Context:    ctx,
+		ResourceID: state.ResourceID.ValueString(),
+		ID:         state.ID.ValueString(),
+		Body: &models.EngineUpdateOutboundResourceDisplayRequest{
+			Display: plan.Display.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.OutboundResourceService.UpdateOutboundResourceDisplay(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceDisplayToTF(state.ResourceID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceDisplayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data OutboundResourceDisplayResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &outbound_resource_service.DeleteOutboundResourceDisplayParams{
+		Context:    ctx,
+		ResourceID: data.ResourceID.ValueString(),
+		ID:         data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.OutboundResourceService.DeleteOutboundResourceDisplay(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *OutboundResourceDisplayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "resource_id", "id")
+}
+
+func outboundResourceDisplayToTF(resourceID string, in *models.EngineResourceDisplay) *OutboundResourceDisplayResourceModel {
+	return &OutboundResourceDisplayResourceModel{
+		ID:         types.StringValue(in.ID),
+		ResourceID: types.StringValue(resourceID),
+		Display:    types.StringValue(in.Display),
+	}
+}
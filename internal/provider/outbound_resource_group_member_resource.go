@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/outbound_resource_group_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OutboundResourceGroupMemberResource{}
+var _ resource.ResourceWithImportState = &OutboundResourceGroupMemberResource{}
+
+type OutboundResourceGroupMemberResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	GroupID           types.String `tfsdk:"group_id"`
+	ResourceID        types.String `tfsdk:"resource_id"`
+	ReserveResourceID types.String `tfsdk:"reserve_resource_id"`
+	Priority          types.Int64  `tfsdk:"priority"`
+}
+
+// OutboundResourceGroupMemberResource defines the resource implementation.
+type OutboundResourceGroupMemberResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewOutboundResourceGroupMemberResource() resource.Resource {
+	return &OutboundResourceGroupMemberResource{}
+}
+
+func (r *OutboundResourceGroupMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_outbound_resource_group_member"
+}
+
+func (r *OutboundResourceGroupMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Outbound Resource membership in the Outbound Resource Group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the group member. Never changes.",
+			},
+			"group_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The Outbound Resource Group ID. Changing this forces a new resource to be created.",
+			},
+			"resource_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Outbound Resource ID.",
+			},
+			"reserve_resource_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The reserve Outbound Resource ID used when the main one is unavailable.",
+			},
+			"priority": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The priority of the Outbound Resource within the group.",
+			},
+		},
+	}
+}
+
+func (r *OutboundResourceGroupMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OutboundResourceGroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data OutboundResourceGroupMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &outbound_resource_group_service.CreateOutboundResourceInGroupParams{
+		Context: ctx,
+		GroupID: data.GroupID.ValueString(),
+		Body: &models.EngineCreateOutboundResourceInGroupRequest{
+			Resource:        &models.EngineLookup{ID: data.ResourceID.ValueString()},
+			ReserveResource: lookupOrNil(data.ReserveResourceID),
+			Priority:        data.Priority.ValueInt64(),
+		},
+	}
+
+	httpResp, err := r.client.OutboundResourceGroupService.CreateOutboundResourceInGroupWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceGroupMemberToTF(data.GroupID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceGroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data OutboundResourceGroupMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &outbound_resource_group_service.ReadOutboundResourceInGroupParams{
+		Context: ctx,
+		GroupID: data.GroupID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.OutboundResourceGroupService.ReadOutboundResourceInGroup(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceGroupMemberToTF(data.GroupID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceGroupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state OutboundResourceGroupMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &outbound_resource_group_service.UpdateOutboundResourceInGroupParams{
+		Context: ctx,
+		GroupID: state.GroupID.ValueString(),
+		ID:      state.ID.ValueString(),
+		Body: &models.EngineUpdateOutboundResourceInGroupRequest{
+			Resource:        &models.EngineLookup{ID: plan.ResourceID.ValueString()},
+			ReserveResource: lookupOrNil(plan.ReserveResourceID),
+			Priority:        plan.Priority.ValueInt64(),
+		},
+	}
+
+	httpResp, err := r.client.OutboundResourceGroupService.UpdateOutboundResourceInGroup(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceGroupMemberToTF(state.GroupID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceGroupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data OutboundResourceGroupMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &outbound_resource_group_service.DeleteOutboundResourceInGroupParams{
+		Context: ctx,
+		GroupID: data.GroupID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.OutboundResourceGroupService.DeleteOutboundResourceInGroup(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *OutboundResourceGroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "group_id", "id")
+}
+
+func outboundResourceGroupMemberToTF(groupID string, in *models.EngineOutboundResourceInGroup) *OutboundResourceGroupMemberResourceModel {
+	out := &OutboundResourceGroupMemberResourceModel{
+		ID:                types.StringValue(in.ID),
+		GroupID:           types.StringValue(groupID),
+		ResourceID:        types.StringNull(),
+		ReserveResourceID: lookupToTF(in.ReserveResource),
+		Priority:          types.Int64Value(in.Priority),
+	}
+
+	if in.Resource != nil {
+		out.ResourceID = types.StringValue(in.Resource.ID)
+	}
+
+	return out
+}
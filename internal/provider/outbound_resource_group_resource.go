@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/outbound_resource_group_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OutboundResourceGroupResource{}
+var _ resource.ResourceWithImportState = &OutboundResourceGroupResource{}
+
+type OutboundResourceGroupResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	Strategy            types.String `tfsdk:"strategy"`
+	CommunicationTypeID types.String `tfsdk:"communication_type_id"`
+	Time                types.List   `tfsdk:"time"`
+}
+
+type OutboundResourceGroupTimeRange struct {
+	StartTimeOfDay types.Int64 `tfsdk:"start_time_of_day"`
+	EndTimeOfDay   types.Int64 `tfsdk:"end_time_of_day"`
+}
+
+// OutboundResourceGroupResource defines the resource implementation.
+type OutboundResourceGroupResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewOutboundResourceGroupResource() resource.Resource {
+	return &OutboundResourceGroupResource{}
+}
+
+func (r *OutboundResourceGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_outbound_resource_group"
+}
+
+func timeRangeSchema() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"start_time_of_day": types.Int64Type,
+			"end_time_of_day":   types.Int64Type,
+		},
+	}
+}
+
+func (r *OutboundResourceGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Outbound Resource Group. Combines Outbound Resources used to dial " +
+			"the members communications of the given type within the time ranges.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Outbound Resource Group. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Outbound Resource Group name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Outbound Resource Group.",
+			},
+			"strategy": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The strategy of choosing the Outbound Resource within the group, e.g. `top_down`.",
+			},
+			"communication_type_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Communication Type ID of the members communications dialed through the group.",
+			},
+			"time": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_time_of_day": schema.Int64Attribute{
+							Required:    true,
+							Description: "The range start in minutes since midnight of the Queue calendar time zone.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 1440),
+							},
+						},
+						"end_time_of_day": schema.Int64Attribute{
+							Required:    true,
+							Description: "The range end in minutes since midnight of the Queue calendar time zone.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 1440),
+							},
+						},
+					},
+				},
+				Description: "The time ranges of the day when the group is used for dialing.",
+			},
+		},
+	}
+}
+
+func (r *OutboundResourceGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OutboundResourceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data OutboundResourceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeRanges, diags := outboundResourceGroupTime(ctx, data.Time)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreateOutboundResourceGroupRequest{
+		Name:          data.Name.ValueString(),
+		Description:   data.Description.ValueString(),
+		Strategy:      data.Strategy.ValueString(),
+		Communication: &models.EngineLookup{ID: data.CommunicationTypeID.ValueString()},
+		Time:          timeRanges,
+	}
+
+	httpResp, err := r.client.OutboundResourceGroupService.CreateOutboundResourceGroupWithParams(&outbound_resource_group_service.CreateOutboundResourceGroupParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceGroupToTF(httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data OutboundResourceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &outbound_resource_group_service.ReadOutboundResourceGroupParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.OutboundResourceGroupService.ReadOutboundResourceGroup(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceGroupToTF(httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state OutboundResourceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeRanges, diags := outboundResourceGroupTime(ctx, plan.Time)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &outbound_resource_group_service.UpdateOutboundResourceGroupParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Body: &models.EngineUpdateOutboundResourceGroupRequest{
+			Name:          plan.Name.ValueString(),
+			Description:   plan.Description.ValueString(),
+			Strategy:      plan.Strategy.ValueString(),
+			Communication: &models.EngineLookup{ID: plan.CommunicationTypeID.ValueString()},
+			Time:          timeRanges,
+		},
+	}
+
+	httpResp, err := r.client.OutboundResourceGroupService.UpdateOutboundResourceGroupWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceGroupToTF(httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data OutboundResourceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &outbound_resource_group_service.DeleteOutboundResourceGroupParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.OutboundResourceGroupService.DeleteOutboundResourceGroup(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *OutboundResourceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func outboundResourceGroupTime(ctx context.Context, l types.List) ([]*models.EngineOutboundResourceTimeRange, diag.Diagnostics) {
+	out := make([]*models.EngineOutboundResourceTimeRange, 0)
+	if l.IsNull() || l.IsUnknown() {
+		return out, nil
+	}
+
+	var ranges []OutboundResourceGroupTimeRange
+	diags := l.ElementsAs(ctx, &ranges, false)
+	for _, v := range ranges {
+		out = append(out, &models.EngineOutboundResourceTimeRange{
+			StartTimeOfDay: int32(v.StartTimeOfDay.ValueInt64()),
+			EndTimeOfDay:   int32(v.EndTimeOfDay.ValueInt64()),
+		})
+	}
+
+	return out, diags
+}
+
+func outboundResourceGroupToTF(in *models.EngineOutboundResourceGroup) *OutboundResourceGroupResourceModel {
+	out := &OutboundResourceGroupResourceModel{
+		ID:                  types.StringValue(in.ID),
+		Name:                types.StringValue(in.Name),
+		Description:         types.StringNull(),
+		Strategy:            types.StringValue(in.Strategy),
+		CommunicationTypeID: types.StringNull(),
+		Time:                types.ListNull(timeRangeSchema()),
+	}
+
+	if in.Description != "" {
+		out.Description = types.StringValue(in.Description)
+	}
+
+	if in.Communication != nil {
+		out.CommunicationTypeID = types.StringValue(in.Communication.ID)
+	}
+
+	if len(in.Time) != 0 {
+		ranges := make([]attr.Value, 0, len(in.Time))
+		for _, v := range in.Time {
+			obj := types.ObjectValueMust(timeRangeSchema().AttributeTypes(), map[string]attr.Value{
+				"start_time_of_day": types.Int64Value(int64(v.StartTimeOfDay)),
+				"end_time_of_day":   types.Int64Value(int64(v.EndTimeOfDay)),
+			})
+
+			ranges = append(ranges, obj)
+		}
+
+		out.Time = types.ListValueMust(timeRangeSchema(), ranges)
+	}
+
+	return out
+}
@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/outbound_resource_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OutboundResourceResource{}
+var _ resource.ResourceWithImportState = &OutboundResourceResource{}
+
+type OutboundResourceResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Description           types.String `tfsdk:"description"`
+	GatewayID             types.String `tfsdk:"gateway_id"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	Reserve               types.Bool   `tfsdk:"reserve"`
+	Limit                 types.Int64  `tfsdk:"limit"`
+	RPS                   types.Int64  `tfsdk:"rps"`
+	MaxSuccessivelyErrors types.Int64  `tfsdk:"max_successively_errors"`
+	ErrorIDs              types.List   `tfsdk:"error_ids"`
+	FailureDialDelay      types.Int64  `tfsdk:"failure_dial_delay"`
+	Patterns              types.List   `tfsdk:"patterns"`
+	Number                types.String `tfsdk:"number"`
+	Variables             types.Map    `tfsdk:"variables"`
+	CIDType               types.String `tfsdk:"cid_type"`
+	IgnoreEarlyMedia      types.String `tfsdk:"ignore_early_media"`
+}
+
+// OutboundResourceResource defines the resource implementation.
+type OutboundResourceResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewOutboundResourceResource() resource.Resource {
+	return &OutboundResourceResource{}
+}
+
+func (r *OutboundResourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_outbound_resource"
+}
+
+func (r *OutboundResourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Outbound Resource. Describes the gateway capacity and error handling " +
+			"used by the dialer to place outbound calls.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Outbound Resource. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Outbound Resource name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Outbound Resource.",
+			},
+			"gateway_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The SIP gateway ID used to place calls.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the Outbound Resource is used by the dialer. Defaults to `true`.",
+			},
+			"reserve": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Use the Outbound Resource only as a reserve of the other resources in the group. Defaults to `false`.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The maximum number of simultaneous calls through the Outbound Resource. Defaults to `0`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"rps": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The maximum number of calls started per second (CPS). Defaults to `0`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_successively_errors": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+				Description: "The number of successive errors listed in `error_ids` after which the Outbound Resource " +
+					"is disabled. `0` means the Outbound Resource is never disabled.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"error_ids": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The SIP response codes counted as errors, e.g. `503` or `5xx`.",
+			},
+			"failure_dial_delay": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The delay in seconds before the next call through the Outbound Resource after a failure.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"patterns": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The caller ID (number) patterns applied to outbound calls.",
+			},
+			"number": schema.StringAttribute{
+				Optional:    true,
+				Description: "The default caller ID (number) of outbound calls.",
+			},
+			"variables": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The channel variables set on outbound calls.",
+			},
+			"cid_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The caller ID header type, e.g. `rpid` or `pid`.",
+			},
+			"ignore_early_media": schema.StringAttribute{
+				Optional:    true,
+				Description: "The early media handling mode of outbound calls, e.g. `true`, `false` or `ring_ready`.",
+			},
+		},
+	}
+}
+
+func (r *OutboundResourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OutboundResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data OutboundResourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in, diags := outboundResourceFromTF(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreateOutboundResourceRequest{
+		Name:                  in.Name,
+		Description:           in.Description,
+		Gateway:               in.Gateway,
+		Enabled:               in.Enabled,
+		Reserve:               in.Reserve,
+		Limit:                 in.Limit,
+		Rps:                   in.Rps,
+		MaxSuccessivelyErrors: in.MaxSuccessivelyErrors,
+		ErrorIds:              in.ErrorIds,
+		FailureDialDelay:      in.FailureDialDelay,
+		Patterns:              in.Patterns,
+		Number:                in.Number,
+		Variables:             in.Variables,
+		Parameters:            in.Parameters,
+	}
+
+	httpResp, err := r.client.OutboundResourceService.CreateOutboundResourceWithParams(&outbound_resource_service.CreateOutboundResourceParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceToTF(httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data OutboundResourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &outbound_resource_service.ReadOutboundResourceParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.OutboundResourceService.ReadOutboundResource(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceToTF(httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state OutboundResourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in, diags := outboundResourceFromTF(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &outbound_resource_service.UpdateOutboundResourceParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Body:    in,
+	}
+
+	httpResp, err := r.client.OutboundResourceService.UpdateOutboundResourceWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, outboundResourceToTF(httpResp.GetPayload()))...)
+}
+
+func (r *OutboundResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data OutboundResourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &outbound_resource_service.DeleteOutboundResourceParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.OutboundResourceService.DeleteOutboundResource(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *OutboundResourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func outboundResourceFromTF(ctx context.Context, data OutboundResourceResourceModel) (*models.EngineUpdateOutboundResourceRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &models.EngineUpdateOutboundResourceRequest{
+		Name:                  data.Name.ValueString(),
+		Description:           data.Description.ValueString(),
+		Gateway:               &models.EngineLookup{ID: data.GatewayID.ValueString()},
+		Enabled:               data.Enabled.ValueBool(),
+		Reserve:               data.Reserve.ValueBool(),
+		Limit:                 int32(data.Limit.ValueInt64()),
+		Rps:                   int32(data.RPS.ValueInt64()),
+		MaxSuccessivelyErrors: int32(data.MaxSuccessivelyErrors.ValueInt64()),
+		ErrorIds:              []string{},
+		FailureDialDelay:      data.FailureDialDelay.ValueInt64(),
+		Patterns:              []string{},
+		Number:                data.Number.ValueString(),
+		Variables:             map[string]string{},
+		Parameters: &models.EngineOutboundResourceParameters{
+			CidType:          data.CIDType.ValueString(),
+			IgnoreEarlyMedia: data.IgnoreEarlyMedia.ValueString(),
+		},
+	}
+
+	if !data.ErrorIDs.IsNull() {
+		diags.Append(data.ErrorIDs.ElementsAs(ctx, &out.ErrorIds, false)...)
+	}
+
+	if !data.Patterns.IsNull() {
+		diags.Append(data.Patterns.ElementsAs(ctx, &out.Patterns, false)...)
+	}
+
+	if !data.Variables.IsNull() {
+		diags.Append(data.Variables.ElementsAs(ctx, &out.Variables, false)...)
+	}
+
+	return out, diags
+}
+
+func outboundResourceToTF(in *models.EngineOutboundResource) *OutboundResourceResourceModel {
+	out := &OutboundResourceResourceModel{
+		ID:                    types.StringValue(in.ID),
+		Name:                  types.StringValue(in.Name),
+		Description:           types.StringNull(),
+		GatewayID:             types.StringNull(),
+		Enabled:               types.BoolValue(in.Enabled),
+		Reserve:               types.BoolValue(in.Reserve),
+		Limit:                 types.Int64Value(int64(in.Limit)),
+		RPS:                   types.Int64Value(int64(in.Rps)),
+		MaxSuccessivelyErrors: types.Int64Value(int64(in.MaxSuccessivelyErrors)),
+		ErrorIDs:              types.ListNull(types.StringType),
+		FailureDialDelay:      types.Int64Value(in.FailureDialDelay),
+		Patterns:              types.ListNull(types.StringType),
+		Number:                types.StringNull(),
+		Variables:             types.MapNull(types.StringType),
+		CIDType:               types.StringNull(),
+		IgnoreEarlyMedia:      types.StringNull(),
+	}
+
+	if in.Description != "" {
+		out.Description = types.StringValue(in.Description)
+	}
+
+	if in.Gateway != nil {
+		out.GatewayID = types.StringValue(in.Gateway.ID)
+	}
+
+	if len(in.ErrorIds) != 0 {
+		out.ErrorIDs = stringsToList(in.ErrorIds)
+	}
+
+	if len(in.Patterns) != 0 {
+		out.Patterns = stringsToList(in.Patterns)
+	}
+
+	if in.Number != "" {
+		out.Number = types.StringValue(in.Number)
+	}
+
+	if len(in.Variables) != 0 {
+		variables := make(map[string]attr.Value, len(in.Variables))
+		for k, v := range in.Variables {
+			variables[k] = types.StringValue(v)
+		}
+
+		out.Variables = types.MapValueMust(types.StringType, variables)
+	}
+
+	if in.Parameters != nil {
+		if in.Parameters.CidType != "" {
+			out.CIDType = types.StringValue(in.Parameters.CidType)
+		}
+
+		if in.Parameters.IgnoreEarlyMedia != "" {
+			out.IgnoreEarlyMedia = types.StringValue(in.Parameters.IgnoreEarlyMedia)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/agent_pause_cause_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PauseCauseResource{}
+var _ resource.ResourceWithImportState = &PauseCauseResource{}
+
+type PauseCauseResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	LimitMin        types.Int64  `tfsdk:"limit_min"`
+	AllowAgent      types.Bool   `tfsdk:"allow_agent"`
+	AllowSupervisor types.Bool   `tfsdk:"allow_supervisor"`
+	AllowAdmin      types.Bool   `tfsdk:"allow_admin"`
+	Description     types.String `tfsdk:"description"`
+}
+
+// PauseCauseResource defines the resource implementation.
+type PauseCauseResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewPauseCauseResource() resource.Resource {
+	return &PauseCauseResource{}
+}
+
+func (r *PauseCauseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pause_cause"
+}
+
+func (r *PauseCauseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Agent Pause Cause dictionary resource. Classifies the reasons the agents go on pause.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Pause Cause. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Pause Cause name.",
+			},
+			"limit_min": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The pause duration limit in minutes. `0` means no limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"allow_agent": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the agents may select the Pause Cause themselves. Defaults to `false`.",
+			},
+			"allow_supervisor": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the supervisors may put the agents on this pause. Defaults to `false`.",
+			},
+			"allow_admin": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the administrators may put the agents on this pause. Defaults to `false`.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Pause Cause.",
+			},
+		},
+	}
+}
+
+func (r *PauseCauseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PauseCauseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data PauseCauseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreateAgentPauseCauseRequest{
+		Name:            data.Name.ValueString(),
+		LimitMin:        data.LimitMin.ValueInt64(),
+		AllowAgent:      data.AllowAgent.ValueBool(),
+		AllowSupervisor: data.AllowSupervisor.ValueBool(),
+		AllowAdmin:      data.AllowAdmin.ValueBool(),
+		Description:     data.Description.ValueString(),
+	}
+
+	httpResp, err := r.client.AgentPauseCauseService.CreateAgentPauseCauseWithParams(&agent_pause_cause_service.CreateAgentPauseCauseParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, pauseCauseToTF(httpResp.GetPayload()))...)
+}
+
+func (r *PauseCauseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data PauseCauseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to parse id", err.Error())
+
+		return
+	}
+
+	input := &agent_pause_cause_service.ReadAgentPauseCauseParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	httpResp, err := r.client.AgentPauseCauseService.ReadAgentPauseCauseWithParams(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, pauseCauseToTF(httpResp.GetPayload()))...)
+}
+
+func (r *PauseCauseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state PauseCauseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to parse id", err.Error())
+
+		return
+	}
+
+	params := &agent_pause_cause_service.UpdateAgentPauseCauseParams{
+		Context: ctx,
+		ID:      id,
+		Body: &models.EngineUpdateAgentPauseCauseRequest{
+			Name:            plan.Name.ValueString(),
+			LimitMin:        plan.LimitMin.ValueInt64(),
+			AllowAgent:      plan.AllowAgent.ValueBool(),
+			AllowSupervisor: plan.AllowSupervisor.ValueBool(),
+			AllowAdmin:      plan.AllowAdmin.ValueBool(),
+			Description:     plan.Description.ValueString(),
+		},
+	}
+
+	httpResp, err := r.client.AgentPauseCauseService.UpdateAgentPauseCauseWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, pauseCauseToTF(httpResp.GetPayload()))...)
+}
+
+func (r *PauseCauseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data PauseCauseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to parse id", err.Error())
+
+		return
+	}
+
+	input := &agent_pause_cause_service.DeleteAgentPauseCauseParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err = r.client.AgentPauseCauseService.DeleteAgentPauseCauseWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *PauseCauseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func pauseCauseToTF(in *models.EngineAgentPauseCause) *PauseCauseResourceModel {
+	return &PauseCauseResourceModel{
+		ID:              types.StringValue(strconv.FormatInt(in.ID, 10)),
+		Name:            types.StringValue(in.Name),
+		LimitMin:        types.Int64Value(in.LimitMin),
+		AllowAgent:      types.BoolValue(in.AllowAgent),
+		AllowSupervisor: types.BoolValue(in.AllowSupervisor),
+		AllowAdmin:      types.BoolValue(in.AllowAdmin),
+		Description:     stringOrNull(in.Description),
+	}
+}
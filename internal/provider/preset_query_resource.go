@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/preset_query_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PresetQueryResource{}
+var _ resource.ResourceWithImportState = &PresetQueryResource{}
+
+var presetQuerySections = []string{
+	string(models.EnginePresetQuerySectionSectionCalls),
+}
+
+type PresetQueryResourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Name        types.String         `tfsdk:"name"`
+	Description types.String         `tfsdk:"description"`
+	Section     types.String         `tfsdk:"section"`
+	Preset      jsontypes.Normalized `tfsdk:"preset"`
+}
+
+// PresetQueryResource defines the resource implementation.
+type PresetQueryResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewPresetQueryResource() resource.Resource {
+	return &PresetQueryResource{}
+}
+
+func (r *PresetQueryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_preset_query"
+}
+
+func (r *PresetQueryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Preset Query resource. The saved filter shown in the agent workspace. " +
+			"Preset Queries belong to the user of the provider credentials.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Preset Query. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Preset Query name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Preset Query.",
+			},
+			"section": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(models.EnginePresetQuerySectionSectionCalls)),
+				Description: "The workspace section the Preset Query filters. Only `section_calls` is supported. Defaults to `section_calls`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(presetQuerySections...),
+				},
+			},
+			"preset": schema.StringAttribute{
+				Required:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "The filter values as JSON, as saved by the workspace.",
+			},
+		},
+	}
+}
+
+func (r *PresetQueryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PresetQueryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data PresetQueryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var preset interface{}
+	resp.Diagnostics.Append(data.Preset.Unmarshal(&preset)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreatePresetQueryRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Section:     models.EnginePresetQuerySection(data.Section.ValueString()).Pointer(),
+		Preset:      preset,
+	}
+
+	httpResp, err := r.client.PresetQueryService.CreatePresetQueryWithParams(&preset_query_service.CreatePresetQueryParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	out, diags := presetQueryToTF(httpResp.GetPayload())
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *PresetQueryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data PresetQueryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(data.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &preset_query_service.ReadPresetQueryParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	httpResp, err := r.client.PresetQueryService.ReadPresetQueryWithParams(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	out, diags := presetQueryToTF(httpResp.GetPayload())
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *PresetQueryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state PresetQueryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	var preset interface{}
+	resp.Diagnostics.Append(plan.Preset.Unmarshal(&preset)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &preset_query_service.UpdatePresetQueryParams{
+		Context: ctx,
+		ID:      id,
+		Body: &models.EngineUpdatePresetQueryRequest{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+			Section:     models.EnginePresetQuerySection(plan.Section.ValueString()).Pointer(),
+			Preset:      preset,
+		},
+	}
+
+	httpResp, err := r.client.PresetQueryService.UpdatePresetQueryWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	out, diags := presetQueryToTF(httpResp.GetPayload())
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *PresetQueryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data PresetQueryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(data.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &preset_query_service.DeletePresetQueryParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.PresetQueryService.DeletePresetQueryWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *PresetQueryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func presetQueryToTF(in *models.EnginePresetQuery) (*PresetQueryResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &PresetQueryResourceModel{
+		ID:          types.StringValue(strconv.FormatInt(int64(in.ID), 10)),
+		Name:        types.StringValue(in.Name),
+		Description: stringOrNull(in.Description),
+		Section:     types.StringNull(),
+		Preset:      jsontypes.NewNormalizedNull(),
+	}
+
+	if in.Section != nil {
+		out.Section = types.StringValue(string(*in.Section))
+	}
+
+	if in.Preset != nil {
+		b, err := json.Marshal(in.Preset)
+		if err != nil {
+			diags.AddError("Unable to encode preset query", err.Error())
+		}
+
+		out.Preset = jsontypes.NewNormalizedValue(string(b))
+	}
+
+	return out, diags
+}
@@ -178,11 +178,64 @@ func (p *WebitelProvider) Configure(ctx context.Context, req provider.ConfigureR
 func (p *WebitelProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewContactResource,
+		NewBucketResource,
+		NewQueueBucketResource,
+		NewQueueSkillResource,
+		NewRoutingSchemaResource,
+		NewRoutingOutboundCallResource,
+		NewOutboundResourceResource,
+		NewOutboundResourceDisplayResource,
+		NewOutboundResourceGroupResource,
+		NewOutboundResourceGroupMemberResource,
+		NewQueueResourceGroupResource,
+		NewListResource,
+		NewListCommunicationResource,
+		NewCommunicationTypeResource,
+		NewQueueMemberResource,
+		NewQueueMemberBulkResource,
+		NewUserResource,
+		NewRoleResource,
+		NewACLGrantResource,
+		NewDeviceResource,
+		NewMediaFileResource,
+		NewRegionResource,
+		NewPauseCauseResource,
+		NewEmailProfileResource,
+		NewStorageProfileResource,
+		NewTriggerResource,
+		NewCaseServiceCatalogResource,
+		NewCaseServiceResource,
+		NewCaseSLAResource,
+		NewCaseSLAConditionResource,
+		NewCasePriorityResource,
+		NewCaseStatusResource,
+		NewCaseStatusConditionResource,
+		NewCaseCloseReasonGroupResource,
+		NewCaseCloseReasonResource,
+		NewCaseSourceResource,
+		NewCaseResource,
+		NewQuickReplyResource,
+		NewChatPlanResource,
+		NewAuditFormResource,
+		NewCognitiveProfileResource,
+		NewPresetQueryResource,
+		NewSystemSettingResource,
+		NewWFMShiftTemplateResource,
+		NewWFMPauseTemplateResource,
+		NewWFMWorkingConditionResource,
+		NewWFMAgentAbsenceResource,
 	}
 }
 
 func (p *WebitelProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewCommunicationTypeDataSource,
+		NewUserDataSource,
+		NewDeviceDataSource,
+		NewCasesDataSource,
+		NewWFMForecastCalculationDataSource,
+		NewWFMForecastDataSource,
+	}
 }
 
 func (p *WebitelProvider) Functions(ctx context.Context) []func() function.Function {
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/queue_bucket_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QueueBucketResource{}
+var _ resource.ResourceWithImportState = &QueueBucketResource{}
+
+type QueueBucketResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	QueueID  types.String `tfsdk:"queue_id"`
+	BucketID types.String `tfsdk:"bucket_id"`
+	Ratio    types.Int64  `tfsdk:"ratio"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Disabled types.Bool   `tfsdk:"disabled"`
+}
+
+// QueueBucketResource defines the resource implementation.
+type QueueBucketResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewQueueBucketResource() resource.Resource {
+	return &QueueBucketResource{}
+}
+
+func (r *QueueBucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue_bucket"
+}
+
+func (r *QueueBucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Queue Bucket binding resource. Attaches a Bucket to the Queue " +
+			"and controls the share of members distributed from it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the binding. Never changes.",
+			},
+			"queue_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The Queue ID the Bucket is attached to. Changing this forces a new resource to be created.",
+			},
+			"bucket_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Bucket ID attached to the Queue.",
+			},
+			"ratio": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The percentage of members distributed from the Bucket.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The Bucket priority within the Queue. Higher value is distributed first.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Temporarily exclude the Bucket from the distribution. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (r *QueueBucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QueueBucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data QueueBucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &queue_bucket_service.CreateQueueBucketParams{
+		Context: ctx,
+		QueueID: data.QueueID.ValueString(),
+		Body: &models.EngineCreateQueueBucketRequest{
+			Bucket:   &models.EngineLookup{ID: data.BucketID.ValueString()},
+			Ratio:    int32(data.Ratio.ValueInt64()),
+			Priority: int32(data.Priority.ValueInt64()),
+			Disabled: data.Disabled.ValueBool(),
+		},
+	}
+
+	httpResp, err := r.client.QueueBucketService.CreateQueueBucketWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueBucketToTF(data.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueBucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data QueueBucketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &queue_bucket_service.ReadQueueBucketParams{
+		Context: ctx,
+		QueueID: data.QueueID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.QueueBucketService.ReadQueueBucketWithParams(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueBucketToTF(data.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueBucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state QueueBucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &queue_bucket_service.UpdateQueueBucketParams{
+		Context: ctx,
+		QueueID: state.QueueID.ValueString(),
+		ID:      state.ID.ValueString(),
+		Body: &models.EngineUpdateQueueBucketRequest{
+			Bucket:   &models.EngineLookup{ID: plan.BucketID.ValueString()},
+			Ratio:    int32(plan.Ratio.ValueInt64()),
+			Priority: int32(plan.Priority.ValueInt64()),
+			Disabled: plan.Disabled.ValueBool(),
+		},
+	}
+
+	httpResp, err := r.client.QueueBucketService.UpdateQueueBucket(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueBucketToTF(state.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueBucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data QueueBucketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &queue_bucket_service.DeleteQueueBucketParams{
+		Context: ctx,
+		QueueID: data.QueueID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.QueueBucketService.DeleteQueueBucketWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *QueueBucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "queue_id", "id")
+}
+
+func queueBucketToTF(queueID string, in *models.EngineQueueBucket) *QueueBucketResourceModel {
+	out := &QueueBucketResourceModel{
+		ID:       types.StringValue(in.ID),
+		QueueID:  types.StringValue(queueID),
+		BucketID: types.StringNull(),
+		Ratio:    types.Int64Value(int64(in.Ratio)),
+		Priority: types.Int64Value(int64(in.Priority)),
+		Disabled: types.BoolValue(in.Disabled),
+	}
+
+	if in.Bucket != nil {
+		out.BucketID = types.StringValue(in.Bucket.ID)
+	}
+
+	return out
+}
@@ -0,0 +1,467 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/member_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+const (
+	// queueMemberBulkSize is the maximum number of members sent in a single bulk request.
+	queueMemberBulkSize = 1000
+	// queueMemberSearchSize is the maximum number of member IDs looked up in a single search request.
+	queueMemberSearchSize = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QueueMemberBulkResource{}
+var _ resource.ResourceWithImportState = &QueueMemberBulkResource{}
+
+type QueueMemberBulkResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	QueueID   types.String `tfsdk:"queue_id"`
+	Members   types.Map    `tfsdk:"members"`
+	MemberIDs types.Map    `tfsdk:"member_ids"`
+}
+
+// QueueMemberBulkResource defines the resource implementation.
+type QueueMemberBulkResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewQueueMemberBulkResource() resource.Resource {
+	return &QueueMemberBulkResource{}
+}
+
+func (r *QueueMemberBulkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue_member_bulk"
+}
+
+func (r *QueueMemberBulkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Queue Member bulk resource. Manages many members of the outbound Queue at once. " +
+			"The new members are created with bulk requests, only the changed members are updated " +
+			"and only the removed members are deleted. Refresh detects the deleted members only, " +
+			"the changes of the member attributes made outside of Terraform are not detected.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The ID of the resource. Equals to the Queue ID.",
+			},
+			"queue_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The Queue ID. Changing this forces a new resource to be created.",
+			},
+			"members": schema.MapNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: queueMemberAttributes,
+				},
+				Description: "The Queue Members keyed by an arbitrary stable key, e.g. the key " +
+					"of the `unique_contact` function result.",
+			},
+			"member_ids": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The Queue Member IDs keyed by the `members` keys.",
+			},
+		},
+	}
+}
+
+func (r *QueueMemberBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QueueMemberBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data QueueMemberBulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var members map[string]QueueMemberModel
+	resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := make(map[string]string, len(members))
+	resp.Diagnostics.Append(r.createMembers(ctx, data.QueueID.ValueString(), members, ids)...)
+
+	// Save the created members even on a partial failure,
+	// so they are not orphaned outside of the Terraform state.
+	data.ID = data.QueueID
+	data.Members, data.MemberIDs = queueMemberBulkToTF(ctx, data.Members, ids)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QueueMemberBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data QueueMemberBulkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids map[string]string
+	resp.Diagnostics.Append(data.MemberIDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.existingMembers(ctx, data.QueueID.ValueString(), ids)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Drop the members deleted outside of Terraform to recreate them
+	for key, id := range ids {
+		if !existing[id] {
+			delete(ids, key)
+		}
+	}
+
+	data.Members, data.MemberIDs = queueMemberBulkToTF(ctx, data.Members, ids)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QueueMemberBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state QueueMemberBulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids map[string]string
+	var members map[string]QueueMemberModel
+	resp.Diagnostics.Append(state.MemberIDs.ElementsAs(ctx, &ids, false)...)
+	resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queueID := state.QueueID.ValueString()
+	prior := state.Members.Elements()
+	planned := plan.Members.Elements()
+
+	// Remove the members which keys are gone from the configuration
+	var removed []string
+	for key := range ids {
+		if _, ok := planned[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	resp.Diagnostics.Append(r.deleteMembers(ctx, queueID, state.MemberIDs, removed)...)
+	if !resp.Diagnostics.HasError() {
+		for _, key := range removed {
+			delete(ids, key)
+		}
+	}
+
+	// Update the changed members and collect the new ones
+	applied := make(map[string]bool, len(members))
+	added := make(map[string]QueueMemberModel)
+	for key, member := range members {
+		id, ok := ids[key]
+		if !ok {
+			added[key] = member
+			continue
+		}
+
+		if prior[key].Equal(planned[key]) {
+			applied[key] = true
+			continue
+		}
+
+		if resp.Diagnostics.HasError() {
+			continue
+		}
+
+		item, diags := queueMemberFromTF(ctx, &member)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			continue
+		}
+
+		params := &member_service.UpdateMemberParams{
+			Context: ctx,
+			QueueID: queueID,
+			ID:      id,
+			Body:    queueMemberUpdateRequest(item),
+		}
+
+		if _, err := r.client.MemberService.UpdateMember(params); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				fmt.Sprintf("An unexpected error occurred while attempting to update the member %q. ", key)+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			continue
+		}
+
+		applied[key] = true
+	}
+
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(r.createMembers(ctx, queueID, added, ids)...)
+	}
+
+	for key := range added {
+		if _, ok := ids[key]; ok {
+			applied[key] = true
+		}
+	}
+
+	// On a partial failure keep the prior values of the members not applied yet,
+	// so the next apply retries them.
+	elements := make(map[string]attr.Value, len(ids))
+	for key := range ids {
+		if applied[key] {
+			elements[key] = planned[key]
+		} else {
+			elements[key] = prior[key]
+		}
+	}
+
+	plan.ID = state.ID
+	plan.Members = types.MapValueMust(plan.Members.ElementType(ctx), elements)
+	plan.MemberIDs = queueMemberIDsToTF(ids)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *QueueMemberBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data QueueMemberBulkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys := make([]string, 0, len(data.MemberIDs.Elements()))
+	for key := range data.MemberIDs.Elements() {
+		keys = append(keys, key)
+	}
+
+	resp.Diagnostics.Append(r.deleteMembers(ctx, data.QueueID.ValueString(), data.MemberIDs, keys)...)
+}
+
+func (r *QueueMemberBulkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddError(
+		"Import Not Supported",
+		"The Queue Member bulk resource cannot be imported, as the member keys are not stored in the API. "+
+			"Use the webitel_queue_member resource to import the individual members.",
+	)
+}
+
+// createMembers creates the members with bulk requests and stores their IDs into ids.
+func (r *QueueMemberBulkResource) createMembers(ctx context.Context, queueID string, members map[string]QueueMemberModel, ids map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for start := 0; start < len(keys); start += queueMemberBulkSize {
+		chunk := keys[start:min(start+queueMemberBulkSize, len(keys))]
+		items := make([]*models.EngineCreateMemberBulkItem, 0, len(chunk))
+		for _, key := range chunk {
+			member := members[key]
+			item, itemDiags := queueMemberFromTF(ctx, &member)
+			diags.Append(itemDiags...)
+			items = append(items, item)
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+
+		params := &member_service.CreateMemberBulkParams{
+			Context: ctx,
+			QueueID: queueID,
+			Body:    &models.EngineCreateMemberBulkRequest{Items: items},
+		}
+
+		httpResp, err := r.client.MemberService.CreateMemberBulkWithParams(params)
+		if err != nil {
+			diags.AddError(
+				"Unable to Create Members",
+				"An unexpected error occurred while attempting to create the members. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return diags
+		}
+
+		created := httpResp.GetPayload().Ids
+		if !httpResp.IsCode(http.StatusOK) || len(created) != len(chunk) {
+			diags.AddError(
+				"Unable to Create Members",
+				fmt.Sprintf("Expected %d member IDs in the bulk response, got: %d. ", len(chunk), len(created))+
+					"Please retry the operation or report this issue to the provider developers.",
+			)
+
+			return diags
+		}
+
+		for i, key := range chunk {
+			ids[key] = created[i]
+		}
+	}
+
+	return diags
+}
+
+// deleteMembers deletes the members of the given keys with bulk requests.
+func (r *QueueMemberBulkResource) deleteMembers(ctx context.Context, queueID string, memberIDs types.Map, keys []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if v, ok := memberIDs.Elements()[key].(types.String); ok && v.ValueString() != "" {
+			ids = append(ids, v.ValueString())
+		}
+	}
+
+	sort.Strings(ids)
+
+	for start := 0; start < len(ids); start += queueMemberBulkSize {
+		// The empty filter deletes all the Queue Members, so the chunk is never empty here.
+		chunk := ids[start:min(start+queueMemberBulkSize, len(ids))]
+		params := &member_service.DeleteMembersParams{
+			Context: ctx,
+			QueueID: queueID,
+			Body:    &models.EngineDeleteMembersRequest{ID: chunk},
+		}
+
+		if _, err := r.client.MemberService.DeleteMembersWithParams(params); err != nil && !isNotFound(err) {
+			diags.AddError(
+				"Unable to Delete Members",
+				"An unexpected error occurred while attempting to delete the members. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// existingMembers returns the set of the given member IDs which still exist in the Queue.
+func (r *QueueMemberBulkResource) existingMembers(ctx context.Context, queueID string, ids map[string]string) (map[string]bool, error) {
+	qid, err := strconv.ParseInt(queueID, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid queue_id %q: %w", queueID, err)
+	}
+
+	all := make([]string, 0, len(ids))
+	for _, id := range ids {
+		all = append(all, id)
+	}
+
+	sort.Strings(all)
+
+	out := make(map[string]bool, len(all))
+	for start := 0; start < len(all); start += queueMemberSearchSize {
+		chunk := all[start:min(start+queueMemberSearchSize, len(all))]
+		size := int32(len(chunk))
+		params := &member_service.SearchMemberInQueueParams{
+			Context: ctx,
+			QueueID: int32(qid),
+			ID:      chunk,
+			Size:    &size,
+			Fields:  []string{"id"},
+		}
+
+		httpResp, err := r.client.MemberService.SearchMemberInQueue(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range httpResp.GetPayload().Items {
+			out[v.ID] = true
+		}
+	}
+
+	return out, nil
+}
+
+// queueMemberBulkToTF keeps only the members with known IDs and returns them along with the IDs.
+func queueMemberBulkToTF(ctx context.Context, members types.Map, ids map[string]string) (types.Map, types.Map) {
+	elements := make(map[string]attr.Value, len(ids))
+	for key, v := range members.Elements() {
+		if _, ok := ids[key]; ok {
+			elements[key] = v
+		}
+	}
+
+	return types.MapValueMust(members.ElementType(ctx), elements), queueMemberIDsToTF(ids)
+}
+
+// queueMemberIDsToTF converts the member IDs into a map value, which is never null.
+func queueMemberIDsToTF(ids map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(ids))
+	for k, v := range ids {
+		elements[k] = types.StringValue(v)
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/member_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QueueMemberResource{}
+var _ resource.ResourceWithImportState = &QueueMemberResource{}
+
+type QueueMemberResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	QueueID types.String `tfsdk:"queue_id"`
+	QueueMemberModel
+}
+
+// QueueMemberModel holds the Queue Member attributes shared with the bulk resource.
+type QueueMemberModel struct {
+	Name           types.String `tfsdk:"name"`
+	Priority       types.Int64  `tfsdk:"priority"`
+	ExpireAt       types.String `tfsdk:"expire_at"`
+	BucketID       types.String `tfsdk:"bucket_id"`
+	TimezoneID     types.String `tfsdk:"timezone_id"`
+	Variables      types.Map    `tfsdk:"variables"`
+	Communications types.List   `tfsdk:"communications"`
+}
+
+type QueueMemberCommunication struct {
+	Code        types.String `tfsdk:"code"`
+	Destination types.String `tfsdk:"destination"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	Display     types.String `tfsdk:"display"`
+	Description types.String `tfsdk:"description"`
+}
+
+// QueueMemberResource defines the resource implementation.
+type QueueMemberResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewQueueMemberResource() resource.Resource {
+	return &QueueMemberResource{}
+}
+
+func (r *QueueMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue_member"
+}
+
+func (r *QueueMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Queue Member resource. A single member dialed by the outbound Queue.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Queue Member. Never changes.",
+			},
+			"queue_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The Queue ID. Changing this forces a new resource to be created.",
+			},
+			"name":           queueMemberAttributes["name"],
+			"priority":       queueMemberAttributes["priority"],
+			"expire_at":      queueMemberAttributes["expire_at"],
+			"bucket_id":      queueMemberAttributes["bucket_id"],
+			"timezone_id":    queueMemberAttributes["timezone_id"],
+			"variables":      queueMemberAttributes["variables"],
+			"communications": queueMemberAttributes["communications"],
+		},
+	}
+}
+
+// queueMemberAttributes are the Queue Member attributes shared with the bulk resource.
+var queueMemberAttributes = map[string]schema.Attribute{
+	"name": schema.StringAttribute{
+		Required:    true,
+		Description: "The Queue Member name.",
+	},
+	"priority": schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(0),
+		Description: "The Queue Member priority. Members with the higher priority are dialed first.",
+	},
+	"expire_at": schema.StringAttribute{
+		Optional:    true,
+		Description: "The expiration timestamp in milliseconds since Unix epoch. The Member is not dialed after it.",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "must be a timestamp in milliseconds"),
+		},
+	},
+	"bucket_id": schema.StringAttribute{
+		Optional:    true,
+		Description: "The Bucket ID of the Queue Member.",
+	},
+	"timezone_id": schema.StringAttribute{
+		Optional:    true,
+		Description: "The Timezone ID of the Queue Member. The Queue calendar is applied in this timezone.",
+	},
+	"variables": schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "The Queue Member variables available in the routing schema.",
+	},
+	"communications": schema.ListNestedAttribute{
+		Required: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"code": schema.StringAttribute{
+					Required:    true,
+					Description: "The type of the communication. Reference on CommunicationType dictionary.",
+				},
+				"destination": schema.StringAttribute{
+					Required:    true,
+					Description: "The communication destination, e.g. the phone number.",
+				},
+				"priority": schema.Int64Attribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     int64default.StaticInt64(0),
+					Description: "The communication priority within the Member.",
+				},
+				"display": schema.StringAttribute{
+					Optional:    true,
+					Description: "The caller ID presented while dialing the communication.",
+				},
+				"description": schema.StringAttribute{
+					Optional:    true,
+					Description: "Short description of the communication.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		Description: "The Queue Member communications. The list is compatible with the " +
+			"`destinations` of the `unique_contact` function result.",
+	},
+}
+
+func (r *QueueMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QueueMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data QueueMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, diags := queueMemberFromTF(ctx, &data.QueueMemberModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &member_service.CreateMemberParams{
+		Context: ctx,
+		QueueID: data.QueueID.ValueString(),
+		Body: &models.EngineCreateMemberRequest{
+			Name:           item.Name,
+			Priority:       item.Priority,
+			ExpireAt:       item.ExpireAt,
+			Bucket:         item.Bucket,
+			Timezone:       item.Timezone,
+			Variables:      item.Variables,
+			Communications: item.Communications,
+		},
+	}
+
+	httpResp, err := r.client.MemberService.CreateMemberWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueMemberToTF(data.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data QueueMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &member_service.ReadMemberParams{
+		Context: ctx,
+		QueueID: data.QueueID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.MemberService.ReadMember(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueMemberToTF(data.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state QueueMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, diags := queueMemberFromTF(ctx, &plan.QueueMemberModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &member_service.UpdateMemberParams{
+		Context: ctx,
+		QueueID: state.QueueID.ValueString(),
+		ID:      state.ID.ValueString(),
+		Body:    queueMemberUpdateRequest(item),
+	}
+
+	httpResp, err := r.client.MemberService.UpdateMember(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueMemberToTF(state.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data QueueMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &member_service.DeleteMemberParams{
+		Context: ctx,
+		QueueID: data.QueueID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.MemberService.DeleteMember(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *QueueMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "queue_id", "id")
+}
+
+func queueMemberCommunicationSchema() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"code":        types.StringType,
+			"destination": types.StringType,
+			"priority":    types.Int64Type,
+			"display":     types.StringType,
+			"description": types.StringType,
+		},
+	}
+}
+
+// queueMemberFromTF converts the Queue Member model into the bulk item,
+// the common subset of the create, bulk create and update requests.
+func queueMemberFromTF(ctx context.Context, data *QueueMemberModel) (*models.EngineCreateMemberBulkItem, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	out := &models.EngineCreateMemberBulkItem{
+		Name:     data.Name.ValueString(),
+		Priority: int32(data.Priority.ValueInt64()),
+		ExpireAt: data.ExpireAt.ValueString(),
+		Bucket:   lookupOrNil(data.BucketID),
+		Timezone: lookupOrNil(data.TimezoneID),
+	}
+
+	if !data.Variables.IsNull() {
+		diags.Append(data.Variables.ElementsAs(ctx, &out.Variables, false)...)
+	}
+
+	var communications []QueueMemberCommunication
+	diags.Append(data.Communications.ElementsAs(ctx, &communications, false)...)
+	out.Communications = make([]*models.EngineMemberCommunicationCreateRequest, 0, len(communications))
+	for _, v := range communications {
+		out.Communications = append(out.Communications, &models.EngineMemberCommunicationCreateRequest{
+			Type:        &models.EngineLookup{ID: v.Code.ValueString()},
+			Destination: v.Destination.ValueString(),
+			Priority:    int32(v.Priority.ValueInt64()),
+			Display:     v.Display.ValueString(),
+			Description: v.Description.ValueString(),
+		})
+	}
+
+	return out, diags
+}
+
+func queueMemberUpdateRequest(in *models.EngineCreateMemberBulkItem) *models.EngineUpdateMemberRequest {
+	return &models.EngineUpdateMemberRequest{
+		Name:           in.Name,
+		Priority:       in.Priority,
+		ExpireAt:       in.ExpireAt,
+		Bucket:         in.Bucket,
+		Timezone:       in.Timezone,
+		Variables:      in.Variables,
+		Communications: in.Communications,
+	}
+}
+
+func queueMemberToTF(queueID string, in *models.EngineMemberInQueue) *QueueMemberResourceModel {
+	out := &QueueMemberResourceModel{
+		ID:      types.StringValue(in.ID),
+		QueueID: types.StringValue(queueID),
+		QueueMemberModel: QueueMemberModel{
+			Name:           types.StringValue(in.Name),
+			Priority:       types.Int64Value(int64(in.Priority)),
+			ExpireAt:       types.StringNull(),
+			BucketID:       lookupToTF(in.Bucket),
+			TimezoneID:     lookupToTF(in.Timezone),
+			Variables:      stringMapToTF(in.Variables),
+			Communications: types.ListNull(queueMemberCommunicationSchema()),
+		},
+	}
+
+	if in.ExpireAt != "" && in.ExpireAt != "0" {
+		out.ExpireAt = types.StringValue(in.ExpireAt)
+	}
+
+	communications := make([]attr.Value, 0, len(in.Communications))
+	for _, v := range in.Communications {
+		obj := types.ObjectValueMust(queueMemberCommunicationSchema().AttributeTypes(), map[string]attr.Value{
+			"code":        lookupToTF(v.Type),
+			"destination": types.StringValue(v.Destination),
+			"priority":    types.Int64Value(int64(v.Priority)),
+			"display":     stringOrNull(v.Display),
+			"description": stringOrNull(v.Description),
+		})
+
+		communications = append(communications, obj)
+	}
+
+	out.Communications = types.ListValueMust(queueMemberCommunicationSchema(), communications)
+
+	return out
+}
@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/queue_resources_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QueueResourceGroupResource{}
+var _ resource.ResourceWithImportState = &QueueResourceGroupResource{}
+
+type QueueResourceGroupResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	QueueID             types.String `tfsdk:"queue_id"`
+	ResourceGroupID     types.String `tfsdk:"resource_group_id"`
+	CommunicationTypeID types.String `tfsdk:"communication_type_id"`
+}
+
+// QueueResourceGroupResource defines the resource implementation.
+type QueueResourceGroupResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewQueueResourceGroupResource() resource.Resource {
+	return &QueueResourceGroupResource{}
+}
+
+func (r *QueueResourceGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue_resource_group"
+}
+
+func (r *QueueResourceGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Queue Resource Group binding resource. Attaches an Outbound Resource Group " +
+			"to the Queue to dial the members through.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the binding. Never changes.",
+			},
+			"queue_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The Queue ID the Outbound Resource Group is attached to. Changing this forces a new resource to be created.",
+			},
+			"resource_group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Outbound Resource Group ID attached to the Queue.",
+			},
+			"communication_type_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Communication Type ID of the attached Outbound Resource Group.",
+			},
+		},
+	}
+}
+
+func (r *QueueResourceGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QueueResourceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data QueueResourceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &queue_resources_service.CreateQueueResourceGroupParams{
+		Context: ctx,
+		QueueID: data.QueueID.ValueString(),
+		Body: &models.EngineCreateQueueResourceGroupRequest{
+			ResourceGroup: &models.EngineLookup{ID: data.ResourceGroupID.ValueString()},
+		},
+	}
+
+	httpResp, err := r.client.QueueResourcesService.CreateQueueResourceGroupWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueResourceGroupToTF(data.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueResourceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data QueueResourceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &queue_resources_service.ReadQueueResourceGroupParams{
+		Context: ctx,
+		QueueID: data.QueueID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.QueueResourcesService.ReadQueueResourceGroup(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueResourceGroupToTF(data.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueResourceGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state QueueResourceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &queue_resources_service.UpdateQueueResourceGroupParams{
+		Context: ctx,
+		QueueID: state.QueueID.ValueString(),
+		ID:      state.ID.ValueString(),
+		Body: &models.EngineUpdateQueueResourceGroupRequest{
+			ResourceGroup: &models.EngineLookup{ID: plan.ResourceGroupID.ValueString()},
+		},
+	}
+
+	httpResp, err := r.client.QueueResourcesService.UpdateQueueResourceGroup(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueResourceGroupToTF(state.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueResourceGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data QueueResourceGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &queue_resources_service.DeleteQueueResourceGroupParams{
+		Context: ctx,
+		QueueID: data.QueueID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.QueueResourcesService.DeleteQueueResourceGroup(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *QueueResourceGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "queue_id", "id")
+}
+
+func queueResourceGroupToTF(queueID string, in *models.EngineQueueResourceGroup) *QueueResourceGroupResourceModel {
+	return &QueueResourceGroupResourceModel{
+		ID:                  types.StringValue(in.ID),
+		QueueID:             types.StringValue(queueID),
+		ResourceGroupID:     lookupToTF(in.ResourceGroup),
+		CommunicationTypeID: lookupToTF(in.Communication),
+	}
+}
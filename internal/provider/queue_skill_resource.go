@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/queue_skill_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QueueSkillResource{}
+var _ resource.ResourceWithImportState = &QueueSkillResource{}
+
+type QueueSkillResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	QueueID     types.String `tfsdk:"queue_id"`
+	SkillID     types.String `tfsdk:"skill_id"`
+	BucketIDs   types.Set    `tfsdk:"bucket_ids"`
+	Lvl         types.Int64  `tfsdk:"lvl"`
+	MinCapacity types.Int64  `tfsdk:"min_capacity"`
+	MaxCapacity types.Int64  `tfsdk:"max_capacity"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+// QueueSkillResource defines the resource implementation.
+type QueueSkillResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewQueueSkillResource() resource.Resource {
+	return &QueueSkillResource{}
+}
+
+func (r *QueueSkillResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue_skill"
+}
+
+func (r *QueueSkillResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Queue Skill binding resource. Defines which agents are eligible for the Queue " +
+			"by the Skill level and capacity, optionally narrowed to the members of specific Buckets.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the binding. Never changes.",
+			},
+			"queue_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The Queue ID the Skill is attached to. Changing this forces a new resource to be created.",
+			},
+			"skill_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Skill ID required to serve the Queue.",
+			},
+			"bucket_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The Bucket IDs the Skill is applied to. If omitted, the Skill is applied to all Queue members.",
+			},
+			"lvl": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The routing level. Agents of the lower level are offered members first.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_capacity": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The minimal agent Skill capacity to match.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"max_capacity": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(100),
+				Description: "The maximal agent Skill capacity to match.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the binding is used for the distribution. Defaults to `true`.",
+			},
+		},
+	}
+}
+
+func (r *QueueSkillResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QueueSkillResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data QueueSkillResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queueID, err := strconv.ParseInt(data.QueueID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to parse queue_id", err.Error())
+
+		return
+	}
+
+	buckets, diags := lookups(ctx, data.BucketIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &queue_skill_service.CreateQueueSkillParams{
+		Context: ctx,
+		QueueID: queueID,
+		Body: &models.EngineCreateQueueSkillRequest{
+			Skill:       &models.EngineLookup{ID: data.SkillID.ValueString()},
+			Buckets:     buckets,
+			Lvl:         int32(data.Lvl.ValueInt64()),
+			MinCapacity: int32(data.MinCapacity.ValueInt64()),
+			MaxCapacity: int32(data.MaxCapacity.ValueInt64()),
+			Enabled:     data.Enabled.ValueBool(),
+		},
+	}
+
+	httpResp, err := r.client.QueueSkillService.CreateQueueSkillWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueSkillToTF(data.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueSkillResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data QueueSkillResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queueID, id, diags := queueSkillIDs(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &queue_skill_service.ReadQueueSkillParams{
+		Context: ctx,
+		QueueID: queueID,
+		ID:      id,
+	}
+
+	httpResp, err := r.client.QueueSkillService.ReadQueueSkillWithParams(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueSkillToTF(data.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueSkillResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state QueueSkillResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queueID, id, diags := queueSkillIDs(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	buckets, diags := lookups(ctx, plan.BucketIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &queue_skill_service.UpdateQueueSkillParams{
+		Context: ctx,
+		QueueID: queueID,
+		ID:      id,
+		Body: &models.EngineUpdateQueueSkillRequest{
+			Skill:       &models.EngineLookup{ID: plan.SkillID.ValueString()},
+			Buckets:     buckets,
+			Lvl:         int32(plan.Lvl.ValueInt64()),
+			MinCapacity: int32(plan.MinCapacity.ValueInt64()),
+			MaxCapacity: int32(plan.MaxCapacity.ValueInt64()),
+			Enabled:     plan.Enabled.ValueBool(),
+		},
+	}
+
+	httpResp, err := r.client.QueueSkillService.UpdateQueueSkill(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, queueSkillToTF(state.QueueID.ValueString(), httpResp.GetPayload()))...)
+}
+
+func (r *QueueSkillResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data QueueSkillResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queueID, id, diags := queueSkillIDs(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &queue_skill_service.DeleteQueueSkillParams{
+		Context: ctx,
+		QueueID: queueID,
+		ID:      id,
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.QueueSkillService.DeleteQueueSkillWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *QueueSkillResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "queue_id", "id")
+}
+
+func queueSkillIDs(data QueueSkillResourceModel) (int64, int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	queueID, err := strconv.ParseInt(data.QueueID.ValueString(), 10, 64)
+	if err != nil {
+		diags.AddError("Unable to parse queue_id", err.Error())
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		diags.AddError("Unable to parse id", err.Error())
+	}
+
+	return queueID, id, diags
+}
+
+func queueSkillToTF(queueID string, in *models.EngineQueueSkill) *QueueSkillResourceModel {
+	out := &QueueSkillResourceModel{
+		ID:          types.StringValue(strconv.FormatInt(in.ID, 10)),
+		QueueID:     types.StringValue(queueID),
+		SkillID:     types.StringNull(),
+		BucketIDs:   types.SetNull(types.StringType),
+		Lvl:         types.Int64Value(int64(in.Lvl)),
+		MinCapacity: types.Int64Value(int64(in.MinCapacity)),
+		MaxCapacity: types.Int64Value(int64(in.MaxCapacity)),
+		Enabled:     types.BoolValue(in.Enabled),
+	}
+
+	if in.Skill != nil {
+		out.SkillID = types.StringValue(in.Skill.ID)
+	}
+
+	if len(in.Buckets) != 0 {
+		buckets := make([]string, 0, len(in.Buckets))
+		for _, v := range in.Buckets {
+			buckets = append(buckets, v.ID)
+		}
+
+		out.BucketIDs = stringsToSet(buckets)
+	}
+
+	return out
+}
@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/quick_replies_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QuickReplyResource{}
+var _ resource.ResourceWithImportState = &QuickReplyResource{}
+
+type QuickReplyResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Text      types.String `tfsdk:"text"`
+	TeamID    types.String `tfsdk:"team_id"`
+	QueueID   types.String `tfsdk:"queue_id"`
+	ArticleID types.String `tfsdk:"article_id"`
+}
+
+// QuickReplyResource defines the resource implementation.
+type QuickReplyResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewQuickReplyResource() resource.Resource {
+	return &QuickReplyResource{}
+}
+
+func (r *QuickReplyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quick_reply"
+}
+
+func (r *QuickReplyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The chat Quick Reply resource. Provides the agents with canned responses in the chat. " +
+			"Without the team and queue the Quick Reply is available to all agents.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Quick Reply. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Quick Reply name shown to the agents.",
+			},
+			"text": schema.StringAttribute{
+				Required:    true,
+				Description: "The text of the response sent to the chat.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Limits the Quick Reply to the agents of the team.",
+			},
+			"queue_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Limits the Quick Reply to the chats of the queue.",
+			},
+			"article_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the knowledge base article linked to the Quick Reply.",
+			},
+		},
+	}
+}
+
+func (r *QuickReplyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QuickReplyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data QuickReplyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreateQuickReplyRequest{
+		Name:    data.Name.ValueString(),
+		Text:    data.Text.ValueString(),
+		Team:    lookupOrNil(data.TeamID),
+		Queue:   lookupOrNil(data.QueueID),
+		Article: lookupOrNil(data.ArticleID),
+	}
+
+	httpResp, err := r.client.QuickRepliesService.CreateQuickReplyWithParams(&quick_replies_service.CreateQuickReplyParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, quickReplyToTF(httpResp.GetPayload()))...)
+}
+
+func (r *QuickReplyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data QuickReplyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to parse id", err.Error())
+
+		return
+	}
+
+	input := &quick_replies_service.ReadQuickReplyParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	httpResp, err := r.client.QuickRepliesService.ReadQuickReplyWithParams(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, quickReplyToTF(httpResp.GetPayload()))...)
+}
+
+func (r *QuickReplyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state QuickReplyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to parse id", err.Error())
+
+		return
+	}
+
+	params := &quick_replies_service.UpdateQuickReplyParams{
+		Context: ctx,
+		ID:      id,
+		Body: &models.EngineUpdateQuickReplyRequest{
+			Name:    plan.Name.ValueString(),
+			Text:    plan.Text.ValueString(),
+			Team:    lookupOrNil(plan.TeamID),
+			Queue:   lookupOrNil(plan.QueueID),
+			Article: lookupOrNil(plan.ArticleID),
+		},
+	}
+
+	httpResp, err := r.client.QuickRepliesService.UpdateQuickReplyWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, quickReplyToTF(httpResp.GetPayload()))...)
+}
+
+func (r *QuickReplyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data QuickReplyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to parse id", err.Error())
+
+		return
+	}
+
+	input := &quick_replies_service.DeleteQuickReplyParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err = r.client.QuickRepliesService.DeleteQuickReplyWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *QuickReplyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func quickReplyToTF(in *models.EngineQuickReply) *QuickReplyResourceModel {
+	return &QuickReplyResourceModel{
+		ID:        types.StringValue(strconv.FormatInt(in.ID, 10)),
+		Name:      types.StringValue(in.Name),
+		Text:      types.StringValue(in.Text),
+		TeamID:    lookupToTF(in.Team),
+		QueueID:   lookupToTF(in.Queue),
+		ArticleID: lookupToTF(in.Article),
+	}
+}
@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/routing_outbound_call_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoutingOutboundCallResource{}
+var _ resource.ResourceWithImportState = &RoutingOutboundCallResource{}
+
+// routingOutboundCallMu serializes rules reordering, as every move
+// shifts the positions of the other rules of the domain.
+var routingOutboundCallMu sync.Mutex
+
+type RoutingOutboundCallResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Pattern     types.String `tfsdk:"pattern"`
+	SchemaID    types.String `tfsdk:"schema_id"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	Position    types.Int64  `tfsdk:"position"`
+}
+
+// RoutingOutboundCallResource defines the resource implementation.
+type RoutingOutboundCallResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewRoutingOutboundCallResource() resource.Resource {
+	return &RoutingOutboundCallResource{}
+}
+
+func (r *RoutingOutboundCallResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_outbound_call"
+}
+
+func (r *RoutingOutboundCallResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The outbound call Routing rule resource. Calls with the destination number matching the pattern " +
+			"are handled by the target Routing Schema. Rules are evaluated in the order of their position.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Routing rule. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Routing rule name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Routing rule.",
+			},
+			"pattern": schema.StringAttribute{
+				Required:    true,
+				Description: "The regular expression the dialed number is matched against, e.g. `^\\+?380\\d{9}$`.",
+			},
+			"schema_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Routing Schema ID that handles matched calls.",
+			},
+			"disabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Temporarily exclude the rule from the evaluation. Defaults to `false`.",
+			},
+			"position": schema.Int64Attribute{
+				Optional: true,
+				Description: "The position of the rule in the evaluation order, where `1` is evaluated first. " +
+					"The rule is moved when it is created or the position changes. Positions are not refreshed " +
+					"from the API, so adding or reordering other rules does not produce changes. " +
+					"If omitted, the rule keeps the position assigned by Webitel.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *RoutingOutboundCallResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RoutingOutboundCallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data RoutingOutboundCallResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreateRoutingOutboundCallRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Pattern:     data.Pattern.ValueString(),
+		Schema:      &models.EngineLookup{ID: data.SchemaID.ValueString()},
+		Disabled:    data.Disabled.ValueBool(),
+	}
+
+	httpResp, err := r.client.RoutingOutboundCallService.CreateRoutingOutboundCallWithParams(&routing_outbound_call_service.CreateRoutingOutboundCallParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	state := routingOutboundCallToTF(httpResp.GetPayload(), data.Position)
+
+	// Save data into Terraform state before moving the rule,
+	// so that the created rule is tracked even if the move fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Position.IsNull() {
+		if err := r.move(ctx, state.ID.ValueString(), data.Position.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Move Routing Rule",
+				"An unexpected error occurred while attempting to set the rule position. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			// Keep the position unset to retry the move on the next apply
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("position"), types.Int64Null())...)
+
+			return
+		}
+	}
+}
+
+func (r *RoutingOutboundCallResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data RoutingOutboundCallResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &routing_outbound_call_service.ReadRoutingOutboundCallParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.RoutingOutboundCallService.ReadRoutingOutboundCall(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, routingOutboundCallToTF(httpResp.GetPayload(), data.Position))...)
+}
+
+func (r *RoutingOutboundCallResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	// to detect only value changes
+	var plan, state RoutingOutboundCallResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState := state
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) || !plan.Pattern.Equal(state.Pattern) ||
+		!plan.SchemaID.Equal(state.SchemaID) || !plan.Disabled.Equal(state.Disabled) {
+		params := &routing_outbound_call_service.UpdateRoutingOutboundCallParams{
+			Context: ctx,
+			ID:      state.ID.ValueString(),
+			Body: &models.EngineUpdateRoutingOutboundCallRequest{
+				Name:        plan.Name.ValueString(),
+				Description: plan.Description.ValueString(),
+				Pattern:     plan.Pattern.ValueString(),
+				Schema:      &models.EngineLookup{ID: plan.SchemaID.ValueString()},
+				Disabled:    plan.Disabled.ValueBool(),
+			},
+		}
+
+		httpResp, err := r.client.RoutingOutboundCallService.UpdateRoutingOutboundCallWithParams(params)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				"An unexpected error occurred while attempting to update the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		// Return error if the HTTP status code is not 200 OK
+		if !httpResp.IsCode(http.StatusOK) {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				"An unexpected error occurred while attempting to update the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+			)
+
+			return
+		}
+
+		newState = *routingOutboundCallToTF(httpResp.GetPayload(), state.Position)
+	}
+
+	if !plan.Position.IsNull() && !plan.Position.Equal(state.Position) {
+		if err := r.move(ctx, state.ID.ValueString(), plan.Position.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Move Routing Rule",
+				"An unexpected error occurred while attempting to set the rule position. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			// Save the updated attributes without the new position
+			resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+
+			return
+		}
+	}
+
+	newState.Position = plan.Position
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *RoutingOutboundCallResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data RoutingOutboundCallResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &routing_outbound_call_service.DeleteRoutingOutboundCallParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.RoutingOutboundCallService.DeleteRoutingOutboundCall(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *RoutingOutboundCallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// move places the rule at the given 1-based position of the evaluation order
+// by moving it to the place of the rule currently holding that position.
+func (r *RoutingOutboundCallResource) move(ctx context.Context, id string, position int64) error {
+	routingOutboundCallMu.Lock()
+	defer routingOutboundCallMu.Unlock()
+
+	order, err := r.order(ctx)
+	if err != nil {
+		return err
+	}
+
+	target := int(position) - 1
+	if target >= len(order) {
+		target = len(order) - 1
+	}
+
+	if target < 0 || order[target] == id {
+		return nil
+	}
+
+	params := &routing_outbound_call_service.MovePositionRoutingOutboundCallParams{
+		Context: ctx,
+		FromID:  id,
+		ToID:    order[target],
+		Body: &models.EngineMovePositionRoutingOutboundCallRequest{
+			FromID: id,
+			ToID:   order[target],
+		},
+	}
+
+	_, err = r.client.RoutingOutboundCallService.MovePositionRoutingOutboundCall(params)
+
+	return err
+}
+
+// order returns the rule IDs of the domain in the evaluation order,
+// i.e. sorted by the position descending.
+func (r *RoutingOutboundCallResource) order(ctx context.Context) ([]string, error) {
+	var (
+		page  int32 = 1
+		size  int32 = 100
+		rules []*models.EngineRoutingOutboundCallCompact
+	)
+
+	for {
+		params := &routing_outbound_call_service.SearchRoutingOutboundCallParams{
+			Context: ctx,
+			Page:    &page,
+			Size:    &size,
+		}
+
+		httpResp, err := r.client.RoutingOutboundCallService.SearchRoutingOutboundCall(params)
+		if err != nil {
+			return nil, err
+		}
+
+		list := httpResp.GetPayload()
+		rules = append(rules, list.Items...)
+		if !list.Next {
+			break
+		}
+
+		page++
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Position > rules[j].Position
+	})
+
+	order := make([]string, 0, len(rules))
+	for _, v := range rules {
+		order = append(order, v.ID)
+	}
+
+	return order, nil
+}
+
+func routingOutboundCallToTF(in *models.EngineRoutingOutboundCall, position types.Int64) *RoutingOutboundCallResourceModel {
+	out := &RoutingOutboundCallResourceModel{
+		ID:          types.StringValue(in.ID),
+		Name:        types.StringValue(in.Name),
+		Description: types.StringNull(),
+		Pattern:     types.StringValue(in.Pattern),
+		SchemaID:    types.StringNull(),
+		Disabled:    types.BoolValue(in.Disabled),
+		Position:    position,
+	}
+
+	if in.Description != "" {
+		out.Description = types.StringValue(in.Description)
+	}
+
+	if in.Schema != nil {
+		out.SchemaID = types.StringValue(in.Schema.ID)
+	}
+
+	return out
+}
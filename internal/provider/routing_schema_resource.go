@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/routing_schema_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoutingSchemaResource{}
+var _ resource.ResourceWithImportState = &RoutingSchemaResource{}
+
+var routingSchemaTypes = []string{
+	string(models.EngineRoutingSchemaTypeDefault),
+	string(models.EngineRoutingSchemaTypeVoice),
+	string(models.EngineRoutingSchemaTypeChat),
+	string(models.EngineRoutingSchemaTypeProcessing),
+	string(models.EngineRoutingSchemaTypeService),
+}
+
+type RoutingSchemaResourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Name        types.String         `tfsdk:"name"`
+	Type        types.String         `tfsdk:"type"`
+	Description types.String         `tfsdk:"description"`
+	Schema      jsontypes.Normalized `tfsdk:"schema"`
+	Payload     jsontypes.Normalized `tfsdk:"payload"`
+	Tags        types.Set            `tfsdk:"tags"`
+	Editor      types.Bool           `tfsdk:"editor"`
+	Debug       types.Bool           `tfsdk:"debug"`
+	CreatedAt   types.String         `tfsdk:"created_at"`
+	UpdatedAt   types.String         `tfsdk:"updated_at"`
+}
+
+// RoutingSchemaResource defines the resource implementation.
+type RoutingSchemaResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewRoutingSchemaResource() resource.Resource {
+	return &RoutingSchemaResource{}
+}
+
+func (r *RoutingSchemaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_schema"
+}
+
+func (r *RoutingSchemaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Routing Schema (flow) resource. Describes the call, chat or processing logic " +
+			"as JSON exported from the flow designer.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Routing Schema. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Routing Schema name.",
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Description: "The Routing Schema type. One of `default`, `voice`, `chat`, `processing` or `service`. " +
+					"Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(routingSchemaTypes...),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Routing Schema.",
+			},
+			"schema": schema.StringAttribute{
+				Required:   true,
+				CustomType: jsontypes.NormalizedType{},
+				Description: "The flow definition as JSON. Whitespace and key order differences " +
+					"are not considered as changes.",
+			},
+			"payload": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "The flow designer metadata as JSON, e.g. nodes layout on the canvas.",
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The Routing Schema tags used to group schemas in the flow list.",
+			},
+			"editor": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Routing Schema is editable with the visual flow designer. Defaults to `false`.",
+			},
+			"debug": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Enable debug logging of the Routing Schema execution. Defaults to `false`.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The creation timestamp in milliseconds since Unix epoch.",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The last update timestamp in milliseconds since Unix epoch.",
+			},
+		},
+	}
+}
+
+func (r *RoutingSchemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RoutingSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data RoutingSchemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flow, payload, tags, diags := routingSchemaFromTF(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreateRoutingSchemaRequest{
+		Name:        data.Name.ValueString(),
+		Type:        models.NewEngineRoutingSchemaType(models.EngineRoutingSchemaType(data.Type.ValueString())),
+		Description: data.Description.ValueString(),
+		Schema:      flow,
+		Payload:     payload,
+		Tags:        tags,
+		Editor:      data.Editor.ValueBool(),
+		Debug:       data.Debug.ValueBool(),
+	}
+
+	httpResp, err := r.client.RoutingSchemaService.CreateRoutingSchemaWithParams(&routing_schema_service.CreateRoutingSchemaParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	state, diags := routingSchemaToTF(httpResp.GetPayload(), data.Payload)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *RoutingSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data RoutingSchemaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &routing_schema_service.ReadRoutingSchemaParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.RoutingSchemaService.ReadRoutingSchema(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	state, diags := routingSchemaToTF(httpResp.GetPayload(), data.Payload)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *RoutingSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state RoutingSchemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flow, payload, tags, diags := routingSchemaFromTF(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &routing_schema_service.UpdateRoutingSchemaParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Body: &models.EngineUpdateRoutingSchemaRequest{
+			Name:        plan.Name.ValueString(),
+			Type:        models.NewEngineRoutingSchemaType(models.EngineRoutingSchemaType(plan.Type.ValueString())),
+			Description: plan.Description.ValueString(),
+			Schema:      flow,
+			Payload:     payload,
+			Tags:        tags,
+			Editor:      plan.Editor.ValueBool(),
+			Debug:       plan.Debug.ValueBool(),
+		},
+	}
+
+	httpResp, err := r.client.RoutingSchemaService.UpdateRoutingSchemaWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	newState, diags := routingSchemaToTF(httpResp.GetPayload(), plan.Payload)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *RoutingSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data RoutingSchemaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &routing_schema_service.DeleteRoutingSchemaParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.RoutingSchemaService.DeleteRoutingSchema(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *RoutingSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func routingSchemaFromTF(ctx context.Context, data RoutingSchemaResourceModel) (interface{}, interface{}, []*models.EngineSchemaTag, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var flow interface{}
+	diags.Append(data.Schema.Unmarshal(&flow)...)
+
+	var payload interface{}
+	if !data.Payload.IsNull() {
+		diags.Append(data.Payload.Unmarshal(&payload)...)
+	}
+
+	tags := make([]*models.EngineSchemaTag, 0)
+	if !data.Tags.IsNull() {
+		var names []string
+		diags.Append(data.Tags.ElementsAs(ctx, &names, false)...)
+		for _, name := range names {
+			tags = append(tags, &models.EngineSchemaTag{Name: name})
+		}
+	}
+
+	return flow, payload, tags, diags
+}
+
+// routingSchemaToTF converts the API schema into the model. The prior payload is
+// kept when both it and the API payload are empty, e.g. "{}" in the config.
+func routingSchemaToTF(in *models.EngineRoutingSchema, priorPayload jsontypes.Normalized) (*RoutingSchemaResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &RoutingSchemaResourceModel{
+		ID:          types.StringValue(in.ID),
+		Name:        types.StringValue(in.Name),
+		Type:        types.StringNull(),
+		Description: types.StringNull(),
+		Schema:      jsontypes.NewNormalizedNull(),
+		Payload:     jsontypes.NewNormalizedNull(),
+		Tags:        types.SetNull(types.StringType),
+		Editor:      types.BoolValue(in.Editor),
+		Debug:       types.BoolValue(in.Debug),
+		CreatedAt:   types.StringValue(in.CreatedAt),
+		UpdatedAt:   types.StringValue(in.UpdatedAt),
+	}
+
+	if in.Type != nil {
+		out.Type = types.StringValue(string(*in.Type))
+	}
+
+	if in.Description != "" {
+		out.Description = types.StringValue(in.Description)
+	}
+
+	if in.Schema != nil {
+		b, err := json.Marshal(in.Schema)
+		if err != nil {
+			diags.AddError("Unable to encode routing schema", err.Error())
+		}
+
+		out.Schema = jsontypes.NewNormalizedValue(string(b))
+	}
+
+	// The API returns an empty object when the payload was never set
+	if in.Payload != nil {
+		b, err := json.Marshal(in.Payload)
+		if err != nil {
+			diags.AddError("Unable to encode routing schema payload", err.Error())
+		}
+
+		if s := string(b); s != "{}" && s != "null" {
+			out.Payload = jsontypes.NewNormalizedValue(s)
+		}
+	}
+
+	if out.Payload.IsNull() && routingSchemaPayloadEmpty(priorPayload) {
+		out.Payload = priorPayload
+	}
+
+	if len(in.Tags) != 0 {
+		tags := make([]string, 0, len(in.Tags))
+		for _, v := range in.Tags {
+			tags = append(tags, v.Name)
+		}
+
+		out.Tags = stringsToSet(tags)
+	}
+
+	return out, diags
+}
+
+// routingSchemaPayloadEmpty reports whether the payload is set to an empty object or null.
+func routingSchemaPayloadEmpty(in jsontypes.Normalized) bool {
+	if in.IsNull() || in.IsUnknown() {
+		return false
+	}
+
+	var v map[string]interface{}
+	if err := json.Unmarshal([]byte(in.ValueString()), &v); err != nil {
+		return false
+	}
+
+	return len(v) == 0
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+func TestRoutingSchemaToTFPayload(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		payload  interface{}
+		prior    jsontypes.Normalized
+		expected jsontypes.Normalized
+	}{
+		{"unset", map[string]interface{}{}, jsontypes.NewNormalizedNull(), jsontypes.NewNormalizedNull()},
+		{"empty object kept", map[string]interface{}{}, jsontypes.NewNormalizedValue("{}"), jsontypes.NewNormalizedValue("{}")},
+		{"null kept", nil, jsontypes.NewNormalizedValue("null"), jsontypes.NewNormalizedValue("null")},
+		{"removed remotely", map[string]interface{}{}, jsontypes.NewNormalizedValue(`{"x":1}`), jsontypes.NewNormalizedNull()},
+		{"set", map[string]interface{}{"x": 1}, jsontypes.NewNormalizedValue("{}"), jsontypes.NewNormalizedValue(`{"x":1}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			out, diags := routingSchemaToTF(&models.EngineRoutingSchema{ID: "1", Payload: tt.payload}, tt.prior)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if !out.Payload.Equal(tt.expected) {
+				t.Errorf("expected payload %s, got %s", tt.expected, out.Payload)
+			}
+		})
+	}
+}
@@ -0,0 +1,507 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/backend_profile_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StorageProfileResource{}
+var _ resource.ResourceWithImportState = &StorageProfileResource{}
+
+// storageProfileProperty describes the backend property exposed as the
+// attribute of the type-specific block.
+type storageProfileProperty struct {
+	Key         string
+	Description string
+	Required    bool
+	Sensitive   bool
+}
+
+// storageProfileType describes the storage backend type and the block
+// holding its properties.
+type storageProfileType struct {
+	Type        string
+	Block       string
+	Description string
+	Properties  map[string]storageProfileProperty
+}
+
+var storageProfilePathPattern = storageProfileProperty{
+	Key:         "pathPattern",
+	Description: "The file path pattern within the storage, e.g. `$DOMAIN/$Y/$M/$D/$H`.",
+}
+
+// storageProfileTypes are the supported storage backends.
+var storageProfileTypes = []storageProfileType{
+	{
+		Type:        "s3",
+		Block:       "s3",
+		Description: "The Amazon S3 or S3-compatible storage properties.",
+		Properties: map[string]storageProfileProperty{
+			"key_id":       {Key: "keyId", Description: "The access key ID.", Required: true},
+			"access_key":   {Key: "accessKey", Description: "The secret access key.", Required: true, Sensitive: true},
+			"bucket_name":  {Key: "bucketName", Description: "The bucket name.", Required: true},
+			"region":       {Key: "region", Description: "The bucket region, e.g. `eu-central-1`.", Required: true},
+			"endpoint":     {Key: "endpoint", Description: "The endpoint of the S3-compatible storage, e.g. `s3.amazonaws.com`."},
+			"path_pattern": storageProfilePathPattern,
+		},
+	},
+	{
+		Type:        "backblaze",
+		Block:       "backblaze",
+		Description: "The Backblaze B2 storage properties.",
+		Properties: map[string]storageProfileProperty{
+			"account_id":      {Key: "accountId", Description: "The account (key) ID.", Required: true},
+			"application_key": {Key: "applicationKey", Description: "The application key.", Required: true, Sensitive: true},
+			"bucket_id":       {Key: "bucketId", Description: "The bucket ID.", Required: true},
+			"bucket_name":     {Key: "bucketName", Description: "The bucket name.", Required: true},
+			"path_pattern":    storageProfilePathPattern,
+		},
+	},
+	{
+		Type:        "dropbox",
+		Block:       "dropbox",
+		Description: "The Dropbox storage properties.",
+		Properties: map[string]storageProfileProperty{
+			"token":        {Key: "token", Description: "The Dropbox access token.", Required: true, Sensitive: true},
+			"path_pattern": storageProfilePathPattern,
+		},
+	},
+	{
+		Type:        "drive",
+		Block:       "google_drive",
+		Description: "The Google Drive storage properties.",
+		Properties: map[string]storageProfileProperty{
+			"email":        {Key: "email", Description: "The service account email.", Required: true},
+			"private_key":  {Key: "privateKey", Description: "The service account private key.", Required: true, Sensitive: true},
+			"directory":    {Key: "directory", Description: "The root directory name.", Required: true},
+			"path_pattern": storageProfilePathPattern,
+		},
+	},
+	{
+		Type:        "local",
+		Block:       "local",
+		Description: "The local file system storage properties.",
+		Properties: map[string]storageProfileProperty{
+			"directory":    {Key: "directory", Description: "The root directory path on the storage server.", Required: true},
+			"path_pattern": storageProfilePathPattern,
+		},
+	},
+}
+
+type StorageProfileResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	MaxSize     types.Int64  `tfsdk:"max_size"`
+	ExpireDays  types.Int64  `tfsdk:"expire_days"`
+	S3          types.Object `tfsdk:"s3"`
+	Backblaze   types.Object `tfsdk:"backblaze"`
+	Dropbox     types.Object `tfsdk:"dropbox"`
+	GoogleDrive types.Object `tfsdk:"google_drive"`
+	Local       types.Object `tfsdk:"local"`
+}
+
+// blocks returns the type-specific blocks of the model by their names.
+func (m *StorageProfileResourceModel) blocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"s3":           &m.S3,
+		"backblaze":    &m.Backblaze,
+		"dropbox":      &m.Dropbox,
+		"google_drive": &m.GoogleDrive,
+		"local":        &m.Local,
+	}
+}
+
+// StorageProfileResource defines the resource implementation.
+type StorageProfileResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewStorageProfileResource() resource.Resource {
+	return &StorageProfileResource{}
+}
+
+func (r *StorageProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_profile"
+}
+
+func storageProfileTypeSchema(t storageProfileType) types.ObjectType {
+	attrTypes := make(map[string]attr.Type, len(t.Properties))
+	for name := range t.Properties {
+		attrTypes[name] = types.StringType
+	}
+
+	return types.ObjectType{AttrTypes: attrTypes}
+}
+
+// storageProfileTypeChanged requires the replacement when the block is
+// added or removed, as the backend type can not be updated.
+func storageProfileTypeChanged(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+}
+
+func (r *StorageProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			Description:   "The unique ID of the Storage Profile. Never changes.",
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The Storage Profile name.",
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Description: "Short description of the Storage Profile.",
+		},
+		"priority": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Description: "The Storage Profile priority. The files are stored to the enabled profile with the highest priority.",
+		},
+		"disabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether the Storage Profile is disabled. Defaults to `false`.",
+		},
+		"max_size": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Description: "The maximum size of the stored files in megabytes. `0` means no limit.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"expire_days": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Description: "The number of days the files are kept before being removed. `0` keeps the files forever.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+	}
+
+	blocks := make([]path.Expression, 0, len(storageProfileTypes))
+	for _, t := range storageProfileTypes {
+		blocks = append(blocks, path.MatchRoot(t.Block))
+	}
+
+	for i, t := range storageProfileTypes {
+		properties := make(map[string]schema.Attribute, len(t.Properties))
+		for name, p := range t.Properties {
+			properties[name] = schema.StringAttribute{
+				Required:    p.Required,
+				Optional:    !p.Required,
+				Sensitive:   p.Sensitive,
+				Description: p.Description,
+			}
+		}
+
+		block := schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: t.Description + " Conflicts with the other storage types.",
+			Attributes:  properties,
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.RequiresReplaceIf(storageProfileTypeChanged,
+					"Changing the storage type requires the replacement.",
+					"Changing the storage type requires the replacement."),
+			},
+		}
+
+		// The validator checks all the blocks, so attach it once
+		if i == 0 {
+			block.Validators = []validator.Object{objectvalidator.ExactlyOneOf(blocks...)}
+		}
+
+		attributes[t.Block] = block
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "The Storage (backend) Profile resource. Stores the call recordings and other files " +
+			"in the S3, Backblaze, Dropbox, Google Drive or local storage.",
+		Attributes: attributes,
+	}
+}
+
+func (r *StorageProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StorageProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data StorageProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typ, properties := storageProfileProperties(&data)
+	input := &models.StorageCreateBackendProfileRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Priority:    int32(data.Priority.ValueInt64()),
+		Disabled:    data.Disabled.ValueBool(),
+		MaxSize:     strconv.FormatInt(data.MaxSize.ValueInt64(), 10),
+		ExpireDays:  int32(data.ExpireDays.ValueInt64()),
+		Type:        typ,
+		Properties:  properties,
+	}
+
+	httpResp, err := r.client.BackendProfileService.CreateBackendProfileWithParams(&backend_profile_service.CreateBackendProfileParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, storageProfileToTF(httpResp.GetPayload(), &data))...)
+}
+
+func (r *StorageProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data StorageProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &backend_profile_service.ReadBackendProfileParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.BackendProfileService.ReadBackendProfile(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, storageProfileToTF(httpResp.GetPayload(), &data))...)
+}
+
+func (r *StorageProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state StorageProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, properties := storageProfileProperties(&plan)
+	params := &backend_profile_service.UpdateBackendProfileParams{
+		Context: ctx,
+		ID:      state.ID.ValueString(),
+		Body: &models.StorageUpdateBackendProfileRequest{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+			Priority:    int32(plan.Priority.ValueInt64()),
+			Disabled:    plan.Disabled.ValueBool(),
+			MaxSize:     strconv.FormatInt(plan.MaxSize.ValueInt64(), 10),
+			ExpireDays:  int32(plan.ExpireDays.ValueInt64()),
+			Properties:  properties,
+		},
+	}
+
+	httpResp, err := r.client.BackendProfileService.UpdateBackendProfileWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, storageProfileToTF(httpResp.GetPayload(), &plan))...)
+}
+
+func (r *StorageProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data StorageProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &backend_profile_service.DeleteBackendProfileParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.BackendProfileService.DeleteBackendProfile(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *StorageProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// storageProfileProperties returns the backend type and properties of the
+// configured type-specific block.
+func storageProfileProperties(data *StorageProfileResourceModel) (string, map[string]string) {
+	blocks := data.blocks()
+	for _, t := range storageProfileTypes {
+		block := blocks[t.Block]
+		if block.IsNull() || block.IsUnknown() {
+			continue
+		}
+
+		properties := make(map[string]string, len(t.Properties))
+		for name, p := range t.Properties {
+			if v, ok := block.Attributes()[name].(types.String); ok && !v.IsNull() && !v.IsUnknown() {
+				properties[p.Key] = v.ValueString()
+			}
+		}
+
+		return t.Type, properties
+	}
+
+	return "", map[string]string{}
+}
+
+// storageProfileToTF converts the Storage Profile keeping the secrets of
+// the prior data, as the API may mask them.
+func storageProfileToTF(in *models.StorageBackendProfile, prior *StorageProfileResourceModel) *StorageProfileResourceModel {
+	out := &StorageProfileResourceModel{
+		ID:          types.StringValue(in.ID),
+		Name:        types.StringValue(in.Name),
+		Description: stringOrNull(in.Description),
+		Priority:    types.Int64Value(int64(in.Priority)),
+		Disabled:    types.BoolValue(in.Disabled),
+		MaxSize:     types.Int64Value(0),
+		ExpireDays:  types.Int64Value(int64(in.ExpireDays)),
+	}
+
+	if size, err := strconv.ParseInt(in.MaxSize, 10, 64); err == nil {
+		out.MaxSize = types.Int64Value(size)
+	}
+
+	blocks, priorBlocks := out.blocks(), prior.blocks()
+	for _, t := range storageProfileTypes {
+		attrTypes := storageProfileTypeSchema(t).AttributeTypes()
+		if t.Type != in.Type {
+			*blocks[t.Block] = types.ObjectNull(attrTypes)
+
+			continue
+		}
+
+		priorBlock := priorBlocks[t.Block]
+		attrs := make(map[string]attr.Value, len(t.Properties))
+		for name, p := range t.Properties {
+			attrs[name] = stringOrNull(in.Properties[p.Key])
+			if p.Sensitive && !priorBlock.IsNull() && !priorBlock.IsUnknown() {
+				attrs[name] = priorBlock.Attributes()[name]
+			}
+		}
+
+		*blocks[t.Block] = types.ObjectValueMust(attrTypes, attrs)
+	}
+
+	return out
+}
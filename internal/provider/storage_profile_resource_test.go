@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+func TestStorageProfileToTF(t *testing.T) {
+	t.Parallel()
+
+	prior := storageProfileToTF(&models.StorageBackendProfile{
+		ID:   "1",
+		Name: "recordings",
+		Type: "s3",
+		Properties: map[string]string{
+			"keyId":      "AKIA",
+			"accessKey":  "secret",
+			"bucketName": "records",
+			"region":     "eu-central-1",
+		},
+	}, &StorageProfileResourceModel{})
+
+	typ, properties := storageProfileProperties(prior)
+	if typ != "s3" {
+		t.Errorf("expected type s3, got %q", typ)
+	}
+
+	expected := map[string]string{
+		"keyId":      "AKIA",
+		"accessKey":  "secret",
+		"bucketName": "records",
+		"region":     "eu-central-1",
+	}
+	if !reflect.DeepEqual(properties, expected) {
+		t.Errorf("expected properties %v, got %v", expected, properties)
+	}
+
+	// The API masks the secrets on read
+	out := storageProfileToTF(&models.StorageBackendProfile{
+		ID:   "1",
+		Name: "recordings",
+		Type: "s3",
+		Properties: map[string]string{
+			"keyId":      "AKIA",
+			"bucketName": "records",
+			"region":     "eu-central-1",
+		},
+	}, prior)
+
+	if got := out.S3.Attributes()["access_key"]; !got.Equal(types.StringValue("secret")) {
+		t.Errorf("expected the prior access_key, got %s", got)
+	}
+
+	if !out.Local.IsNull() {
+		t.Errorf("expected null local block, got %s", out.Local)
+	}
+}
@@ -0,0 +1,563 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/system_setting_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SystemSettingResource{}
+var _ resource.ResourceWithImportState = &SystemSettingResource{}
+var _ resource.ResourceWithValidateConfig = &SystemSettingResource{}
+
+// The System Setting value kinds, named after the attribute holding the value.
+const (
+	systemSettingBool   = "bool_value"
+	systemSettingInt    = "int_value"
+	systemSettingString = "string_value"
+	systemSettingJSON   = "json_value"
+)
+
+// systemSettingCatalog maps the known System Settings to the kind of their value.
+var systemSettingCatalog = map[string]string{
+	string(models.EngineSystemSettingNameEnableOmnichannel):       systemSettingBool,
+	string(models.EngineSystemSettingNameMemberChunkSize):         systemSettingInt,
+	string(models.EngineSystemSettingNameAmdCancelNotHuman):       systemSettingBool,
+	string(models.EngineSystemSettingNameSchemeVersionLimit):      systemSettingInt,
+	string(models.EngineSystemSettingNameEnable2fa):               systemSettingBool,
+	string(models.EngineSystemSettingNameExportSettings):          systemSettingJSON,
+	string(models.EngineSystemSettingNameSearchNumberLength):      systemSettingInt,
+	string(models.EngineSystemSettingNameChatAiConnection):        systemSettingString,
+	string(models.EngineSystemSettingNamePasswordRegExp):          systemSettingString,
+	string(models.EngineSystemSettingNamePasswordValidationText):  systemSettingString,
+	string(models.EngineSystemSettingNameAutolinkCallToContact):   systemSettingBool,
+	string(models.EngineSystemSettingNamePeriodToPlaybackRecords): systemSettingInt,
+	string(models.EngineSystemSettingNameIsFulltextSearchEnabled): systemSettingBool,
+}
+
+// systemSettingNames returns the sorted names of the known System Settings.
+func systemSettingNames() []string {
+	names := make([]string, 0, len(systemSettingCatalog))
+	for name := range systemSettingCatalog {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+type SystemSettingResourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	Name        types.String         `tfsdk:"name"`
+	BoolValue   types.Bool           `tfsdk:"bool_value"`
+	IntValue    types.Int64          `tfsdk:"int_value"`
+	StringValue types.String         `tfsdk:"string_value"`
+	JSONValue   jsontypes.Normalized `tfsdk:"json_value"`
+}
+
+// SystemSettingResource defines the resource implementation.
+type SystemSettingResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewSystemSettingResource() resource.Resource {
+	return &SystemSettingResource{}
+}
+
+func (r *SystemSettingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_setting"
+}
+
+func (r *SystemSettingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The domain System Setting resource. Tunes the domain-wide behavior, " +
+			"e.g. the answering machine detection or the password policy. " +
+			"The value is set with the attribute matching the setting kind: " + systemSettingCatalogDescription() + ".",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the System Setting. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The System Setting name, e.g. `amd_cancel_not_human`. Changing this forces a new resource to be created.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(systemSettingNames()...),
+				},
+			},
+			"bool_value": schema.BoolAttribute{
+				Optional:    true,
+				Description: "The value of the boolean System Setting.",
+			},
+			"int_value": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The value of the integer System Setting.",
+			},
+			"string_value": schema.StringAttribute{
+				Optional:    true,
+				Description: "The value of the string System Setting.",
+			},
+			"json_value": schema.StringAttribute{
+				Optional:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "The value of the structured System Setting as JSON.",
+			},
+		},
+	}
+}
+
+func (r *SystemSettingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SystemSettingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSystemSetting(&data)...)
+}
+
+func (r *SystemSettingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data SystemSettingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value, diags := systemSettingValue(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.EngineCreateSystemSettingRequest{
+		Name:  models.EngineSystemSettingName(data.Name.ValueString()).Pointer(),
+		Value: value,
+	}
+
+	httpResp, err := r.client.SystemSettingService.CreateSystemSettingWithParams(&system_setting_service.CreateSystemSettingParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	out, diags := systemSettingToTF(httpResp.GetPayload())
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *SystemSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data SystemSettingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(data.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &system_setting_service.ReadSystemSettingParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	httpResp, err := r.client.SystemSettingService.ReadSystemSettingWithParams(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	out, diags := systemSettingToTF(httpResp.GetPayload())
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *SystemSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state SystemSettingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	value, diags := systemSettingValue(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &system_setting_service.UpdateSystemSettingParams{
+		Context: ctx,
+		ID:      id,
+		Body: &models.EngineUpdateSystemSettingRequest{
+			Value: value,
+		},
+	}
+
+	httpResp, err := r.client.SystemSettingService.UpdateSystemSettingWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	out, diags := systemSettingToTF(httpResp.GetPayload())
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *SystemSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data SystemSettingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(data.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &system_setting_service.DeleteSystemSettingParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.SystemSettingService.DeleteSystemSettingWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *SystemSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := strconv.ParseInt(req.ID, 10, 32); err == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+		return
+	}
+
+	// The settings are unique within the domain, so they are imported by name
+	setting, err := r.findByName(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Import Resource",
+			"An unexpected error occurred while attempting to find the System Setting. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if setting == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the ID or the name of an existing System Setting. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.FormatInt(int64(setting.ID), 10))...)
+}
+
+// findByName returns the System Setting with the given name, or nil.
+func (r *SystemSettingResource) findByName(ctx context.Context, name string) (*models.EngineSystemSetting, error) {
+	size := int32(100)
+	for page := int32(1); ; page++ {
+		params := &system_setting_service.SearchSystemSettingParams{
+			Context: ctx,
+			Page:    &page,
+			Size:    &size,
+			Name:    []string{name},
+		}
+
+		httpResp, err := r.client.SystemSettingService.SearchSystemSetting(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range httpResp.GetPayload().Items {
+			if v.Name != nil && string(*v.Name) == name {
+				return v, nil
+			}
+		}
+
+		if !httpResp.GetPayload().Next {
+			return nil, nil
+		}
+	}
+}
+
+// systemSettingCatalogDescription lists the known System Settings with
+// the attributes holding their values.
+func systemSettingCatalogDescription() string {
+	names := systemSettingNames()
+	items := make([]string, 0, len(names))
+	for _, name := range names {
+		items = append(items, fmt.Sprintf("`%s` (`%s`)", name, systemSettingCatalog[name]))
+	}
+
+	return strings.Join(items, ", ")
+}
+
+// validateSystemSetting checks the value is set with exactly the attribute
+// matching the kind of the known System Setting.
+func validateSystemSetting(data *SystemSettingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.Name.IsNull() || data.Name.IsUnknown() {
+		return diags
+	}
+
+	name := data.Name.ValueString()
+	kind, ok := systemSettingCatalog[name]
+	if !ok {
+		return diags
+	}
+
+	values := []struct {
+		attr    string
+		null    bool
+		unknown bool
+	}{
+		{systemSettingBool, data.BoolValue.IsNull(), data.BoolValue.IsUnknown()},
+		{systemSettingInt, data.IntValue.IsNull(), data.IntValue.IsUnknown()},
+		{systemSettingString, data.StringValue.IsNull(), data.StringValue.IsUnknown()},
+		{systemSettingJSON, data.JSONValue.IsNull(), data.JSONValue.IsUnknown()},
+	}
+
+	for _, v := range values {
+		switch {
+		case v.attr == kind && v.null:
+			diags.AddAttributeError(
+				path.Root(v.attr),
+				"Missing System Setting Value",
+				fmt.Sprintf("The %s setting requires the %s.", name, kind),
+			)
+		case v.attr != kind && !v.null && !v.unknown:
+			diags.AddAttributeError(
+				path.Root(v.attr),
+				"Invalid System Setting Value",
+				fmt.Sprintf("The %s setting is set with the %s, got the %s.", name, kind, v.attr),
+			)
+		}
+	}
+
+	return diags
+}
+
+// systemSettingValue returns the value of the attribute matching the
+// kind of the System Setting.
+func systemSettingValue(data *SystemSettingResourceModel) (interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	switch systemSettingCatalog[data.Name.ValueString()] {
+	case systemSettingBool:
+		return data.BoolValue.ValueBool(), diags
+	case systemSettingInt:
+		return data.IntValue.ValueInt64(), diags
+	case systemSettingString:
+		return data.StringValue.ValueString(), diags
+	}
+
+	var value interface{}
+	diags.Append(data.JSONValue.Unmarshal(&value)...)
+
+	return value, diags
+}
+
+func systemSettingToTF(in *models.EngineSystemSetting) (*SystemSettingResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &SystemSettingResourceModel{
+		ID:          types.StringValue(strconv.FormatInt(int64(in.ID), 10)),
+		Name:        types.StringNull(),
+		BoolValue:   types.BoolNull(),
+		IntValue:    types.Int64Null(),
+		StringValue: types.StringNull(),
+		JSONValue:   jsontypes.NewNormalizedNull(),
+	}
+
+	if in.Name != nil {
+		out.Name = types.StringValue(string(*in.Name))
+	}
+
+	// The API omits the zero values, and keeps the structured and unknown
+	// settings as JSON
+	ok := true
+	switch systemSettingCatalog[out.Name.ValueString()] {
+	case systemSettingBool:
+		var v bool
+		if in.Value != nil {
+			v, ok = in.Value.(bool)
+		}
+
+		out.BoolValue = types.BoolValue(v)
+	case systemSettingInt:
+		var v int64
+		if in.Value != nil {
+			v, ok = systemSettingInt64(in.Value)
+		}
+
+		out.IntValue = types.Int64Value(v)
+	case systemSettingString:
+		var v string
+		if in.Value != nil {
+			v, ok = in.Value.(string)
+		}
+
+		out.StringValue = types.StringValue(v)
+	default:
+		if in.Value != nil {
+			b, err := json.Marshal(in.Value)
+			if err != nil {
+				diags.AddError("Unable to encode system setting value", err.Error())
+			}
+
+			out.JSONValue = jsontypes.NewNormalizedValue(string(b))
+		}
+	}
+
+	if !ok {
+		diags.AddError(
+			"Unexpected System Setting Value",
+			fmt.Sprintf("The %s setting value %v does not match the setting kind. "+
+				"Please report this issue to the provider developers.", out.Name.ValueString(), in.Value),
+		)
+	}
+
+	return out, diags
+}
+
+// systemSettingInt64 returns the integer of the decoded JSON number, which
+// may also be sent as a string.
+func systemSettingInt64(in interface{}) (int64, bool) {
+	switch v := in.(type) {
+	case float64:
+		return int64(v), v == float64(int64(v))
+	case json.Number:
+		n, err := v.Int64()
+
+		return n, err == nil
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+
+		return n, err == nil
+	}
+
+	return 0, false
+}
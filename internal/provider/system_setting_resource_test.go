@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+func TestValidateSystemSetting(t *testing.T) {
+	t.Parallel()
+
+	setting := func(name string) *SystemSettingResourceModel {
+		return &SystemSettingResourceModel{
+			Name:        types.StringValue(name),
+			BoolValue:   types.BoolNull(),
+			IntValue:    types.Int64Null(),
+			StringValue: types.StringNull(),
+			JSONValue:   jsontypes.NewNormalizedNull(),
+		}
+	}
+
+	valid := setting("amd_cancel_not_human")
+	valid.BoolValue = types.BoolValue(true)
+
+	missing := setting("member_chunk_size")
+
+	wrong := setting("member_chunk_size")
+	wrong.IntValue = types.Int64Value(100)
+	wrong.StringValue = types.StringValue("100")
+
+	unknown := setting("password_reg_exp")
+	unknown.StringValue = types.StringUnknown()
+
+	testCases := map[string]struct {
+		data     *SystemSettingResourceModel
+		expected []string
+	}{
+		"valid":   {data: valid},
+		"missing": {data: missing, expected: []string{"Missing System Setting Value"}},
+		"wrong":   {data: wrong, expected: []string{"Invalid System Setting Value"}},
+		"unknown": {data: unknown},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateSystemSetting(testCase.data)
+			if len(diags) != len(testCase.expected) {
+				t.Fatalf("expected %d diagnostics, got: %v", len(testCase.expected), diags)
+			}
+
+			for i, d := range diags {
+				if d.Summary() != testCase.expected[i] {
+					t.Errorf("expected %q, got %q", testCase.expected[i], d.Summary())
+				}
+			}
+		})
+	}
+}
+
+func TestSystemSettingToTF(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name     models.EngineSystemSettingName
+		value    interface{}
+		expected SystemSettingResourceModel
+		err      bool
+	}{
+		"bool": {
+			name:     models.EngineSystemSettingNameEnable2fa,
+			value:    true,
+			expected: SystemSettingResourceModel{BoolValue: types.BoolValue(true)},
+		},
+		"bool-omitted": {
+			name:     models.EngineSystemSettingNameEnable2fa,
+			expected: SystemSettingResourceModel{BoolValue: types.BoolValue(false)},
+		},
+		"int": {
+			name:     models.EngineSystemSettingNameMemberChunkSize,
+			value:    float64(100),
+			expected: SystemSettingResourceModel{IntValue: types.Int64Value(100)},
+		},
+		"int-as-string": {
+			name:     models.EngineSystemSettingNameSearchNumberLength,
+			value:    "7",
+			expected: SystemSettingResourceModel{IntValue: types.Int64Value(7)},
+		},
+		"string": {
+			name:     models.EngineSystemSettingNamePasswordRegExp,
+			value:    "^.{8,}$",
+			expected: SystemSettingResourceModel{StringValue: types.StringValue("^.{8,}$")},
+		},
+		"json": {
+			name:     models.EngineSystemSettingNameExportSettings,
+			value:    map[string]interface{}{"format": "csv"},
+			expected: SystemSettingResourceModel{JSONValue: jsontypes.NewNormalizedValue(`{"format":"csv"}`)},
+		},
+		"mismatch": {
+			name:  models.EngineSystemSettingNameEnable2fa,
+			value: "yes",
+			err:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			out, diags := systemSettingToTF(&models.EngineSystemSetting{ID: 1, Name: testCase.name.Pointer(), Value: testCase.value})
+			if diags.HasError() != testCase.err {
+				t.Fatalf("expected error %t, got: %v", testCase.err, diags)
+			}
+
+			if testCase.err {
+				return
+			}
+
+			// The zero values of the expected model are null
+			if !out.BoolValue.Equal(testCase.expected.BoolValue) ||
+				!out.IntValue.Equal(testCase.expected.IntValue) ||
+				!out.StringValue.Equal(testCase.expected.StringValue) ||
+				!out.JSONValue.Equal(testCase.expected.JSONValue) {
+				t.Errorf("expected %s %s %s %s, got %s %s %s %s",
+					testCase.expected.BoolValue, testCase.expected.IntValue, testCase.expected.StringValue, testCase.expected.JSONValue,
+					out.BoolValue, out.IntValue, out.StringValue, out.JSONValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,483 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	// Embed the timezone database, so the next run time is computed in the
+	// trigger timezone on the hosts without one.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/trigger_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// triggerTypes are the supported Trigger types.
+var triggerTypes = []string{
+	string(models.EngineTriggerTypeCron),
+	string(models.EngineTriggerTypeCase),
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TriggerResource{}
+var _ resource.ResourceWithImportState = &TriggerResource{}
+var _ resource.ResourceWithValidateConfig = &TriggerResource{}
+var _ resource.ResourceWithModifyPlan = &TriggerResource{}
+
+type TriggerResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	Expression  types.String `tfsdk:"expression"`
+	TimezoneID  types.String `tfsdk:"timezone_id"`
+	SchemaID    types.String `tfsdk:"schema_id"`
+	Variables   types.Map    `tfsdk:"variables"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	NextRunAt   types.String `tfsdk:"next_run_at"`
+}
+
+// TriggerResource defines the resource implementation.
+type TriggerResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewTriggerResource() resource.Resource {
+	return &TriggerResource{}
+}
+
+func (r *TriggerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger"
+}
+
+func (r *TriggerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Trigger resource. Runs the processing Routing Schema on schedule or on the case events.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Trigger. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Trigger name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Trigger.",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(models.EngineTriggerTypeCron)),
+				Description: "The Trigger type. One of `cron` or `case` (event). Defaults to `cron`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(triggerTypes...),
+				},
+			},
+			"expression": schema.StringAttribute{
+				Optional: true,
+				Description: "The Trigger expression. Required for the `cron` Triggers: the standard five-field " +
+					"cron expression, e.g. `0 2 * * *`, or one of `@hourly`, `@daily`, `@weekly`, `@monthly` or `@yearly`.",
+			},
+			"timezone_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the timezone the cron expression is evaluated in.",
+			},
+			"schema_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the processing Routing Schema to run.",
+			},
+			"variables": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The variables passed to the Routing Schema.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The Routing Schema execution timeout in seconds. `0` means no timeout.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Trigger is enabled. Defaults to `false`.",
+			},
+			"next_run_at": schema.StringAttribute{
+				Computed: true,
+				Description: "The preview of the next time the enabled `cron` Trigger fires, in RFC 3339 format " +
+					"in the Trigger timezone. Updated on refresh.",
+			},
+		},
+	}
+}
+
+func (r *TriggerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the cron expression at plan time.
+func (r *TriggerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TriggerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The type defaults to cron
+	if data.Type.IsUnknown() || data.Expression.IsUnknown() ||
+		(!data.Type.IsNull() && data.Type.ValueString() != string(models.EngineTriggerTypeCron)) {
+		return
+	}
+
+	if data.Expression.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expression"),
+			"Missing Cron Expression",
+			"The expression is required for the cron Trigger.",
+		)
+
+		return
+	}
+
+	s, err := parseCron(data.Expression.ValueString())
+	if err == nil && s.next(time.Now()).IsZero() {
+		err = fmt.Errorf("the schedule never fires")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expression"),
+			"Invalid Cron Expression",
+			fmt.Sprintf("The expression %q is not a valid cron expression: %s.", data.Expression.ValueString(), err),
+		)
+	}
+}
+
+// ModifyPlan keeps the next run time unless the schedule changes, as the
+// preview is only computed on apply and refresh.
+func (r *TriggerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state TriggerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Type.Equal(state.Type) && plan.Expression.Equal(state.Expression) &&
+		plan.TimezoneID.Equal(state.TimezoneID) && plan.Enabled.Equal(state.Enabled) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_run_at"), state.NextRunAt)...)
+	}
+}
+
+func (r *TriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data TriggerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var variables map[string]string
+	if !data.Variables.IsNull() {
+		resp.Diagnostics.Append(data.Variables.ElementsAs(ctx, &variables, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	input := &models.EngineCreateTriggerRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Type:        models.NewEngineTriggerType(models.EngineTriggerType(data.Type.ValueString())),
+		Expression:  data.Expression.ValueString(),
+		Timezone:    lookupOrNil(data.TimezoneID),
+		Schema:      lookupOrNil(data.SchemaID),
+		Variables:   variables,
+		Timeout:     int32(data.Timeout.ValueInt64()),
+		Enabled:     data.Enabled.ValueBool(),
+	}
+
+	httpResp, err := r.client.TriggerService.CreateTriggerWithParams(&trigger_service.CreateTriggerParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, triggerToTF(httpResp.GetPayload(), time.Now()))...)
+}
+
+func (r *TriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data TriggerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(data.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &trigger_service.ReadTriggerParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	httpResp, err := r.client.TriggerService.ReadTriggerWithParams(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, triggerToTF(httpResp.GetPayload(), time.Now()))...)
+}
+
+func (r *TriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state TriggerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(state.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var variables map[string]string
+	if !plan.Variables.IsNull() {
+		resp.Diagnostics.Append(plan.Variables.ElementsAs(ctx, &variables, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	params := &trigger_service.UpdateTriggerParams{
+		Context: ctx,
+		ID:      id,
+		Body: &models.EngineUpdateTriggerRequest{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+			Type:        models.NewEngineTriggerType(models.EngineTriggerType(plan.Type.ValueString())),
+			Expression:  plan.Expression.ValueString(),
+			Timezone:    lookupOrNil(plan.TimezoneID),
+			Schema:      lookupOrNil(plan.SchemaID),
+			Variables:   variables,
+			Timeout:     int32(plan.Timeout.ValueInt64()),
+			Enabled:     plan.Enabled.ValueBool(),
+		},
+	}
+
+	httpResp, err := r.client.TriggerService.UpdateTriggerWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	out := triggerToTF(httpResp.GetPayload(), time.Now())
+
+	// Keep the planned next run time when the schedule is unchanged
+	if !plan.NextRunAt.IsUnknown() {
+		out.NextRunAt = plan.NextRunAt
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *TriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data TriggerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := int32ID(data.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &trigger_service.DeleteTriggerParams{
+		Context: ctx,
+		ID:      id,
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.TriggerService.DeleteTriggerWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *TriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// triggerNextRunAt returns the next time the enabled cron Trigger fires
+// after now, or null.
+func triggerNextRunAt(in *models.EngineTrigger, now time.Time) types.String {
+	if !in.Enabled || !triggerIsCron(in) {
+		return types.StringNull()
+	}
+
+	s, err := parseCron(in.Expression)
+	if err != nil {
+		return types.StringNull()
+	}
+
+	loc := time.UTC
+	if in.Timezone != nil && in.Timezone.Name != "" {
+		if v, err := time.LoadLocation(in.Timezone.Name); err == nil {
+			loc = v
+		}
+	}
+
+	next := s.next(now.In(loc))
+	if next.IsZero() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(next.Format(time.RFC3339))
+}
+
+// triggerIsCron reports whether the Trigger runs on a cron schedule. The API
+// omits the type or reports the default one for the cron Triggers.
+func triggerIsCron(in *models.EngineTrigger) bool {
+	return in.Type == nil ||
+		*in.Type == models.EngineTriggerTypeCron ||
+		*in.Type == models.EngineTriggerTypeDefaultTriggerType
+}
+
+func triggerToTF(in *models.EngineTrigger, now time.Time) *TriggerResourceModel {
+	out := &TriggerResourceModel{
+		ID:          types.StringValue(strconv.FormatInt(int64(in.ID), 10)),
+		Name:        types.StringValue(in.Name),
+		Description: stringOrNull(in.Description),
+		Type:        types.StringValue(string(models.EngineTriggerTypeCron)),
+		Expression:  stringOrNull(in.Expression),
+		TimezoneID:  lookupToTF(in.Timezone),
+		SchemaID:    lookupToTF(in.Schema),
+		Variables:   stringMapToTF(in.Variables),
+		Timeout:     types.Int64Value(int64(in.Timeout)),
+		Enabled:     types.BoolValue(in.Enabled),
+		NextRunAt:   triggerNextRunAt(in, now),
+	}
+
+	if !triggerIsCron(in) {
+		out.Type = types.StringValue(string(*in.Type))
+	}
+
+	return out
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+func TestTriggerToTF(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+	cron := models.EngineTriggerTypeCron
+	defaultType := models.EngineTriggerTypeDefaultTriggerType
+
+	testCases := map[string]struct {
+		trigger   *models.EngineTrigger
+		nextRunAt string
+	}{
+		"cron": {
+			trigger:   &models.EngineTrigger{Type: &cron, Expression: "0 2 * * *", Enabled: true},
+			nextRunAt: "2025-01-16T02:00:00Z",
+		},
+		"no-type": {
+			trigger:   &models.EngineTrigger{Expression: "0 2 * * *", Enabled: true},
+			nextRunAt: "2025-01-16T02:00:00Z",
+		},
+		"default-type": {
+			trigger:   &models.EngineTrigger{Type: &defaultType, Expression: "0 2 * * *", Enabled: true},
+			nextRunAt: "2025-01-16T02:00:00Z",
+		},
+		"disabled": {
+			trigger: &models.EngineTrigger{Type: &cron, Expression: "0 2 * * *"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := triggerToTF(testCase.trigger, now)
+
+			if got.Type.ValueString() != string(models.EngineTriggerTypeCron) {
+				t.Errorf("unexpected type: %s", got.Type)
+			}
+
+			if testCase.nextRunAt == "" {
+				if !got.NextRunAt.IsNull() {
+					t.Errorf("expected null next_run_at, got: %s", got.NextRunAt)
+				}
+
+				return
+			}
+
+			if got.NextRunAt.ValueString() != testCase.nextRunAt {
+				t.Errorf("expected next_run_at %s, got: %s", testCase.nextRunAt, got.NextRunAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/users"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UserDataSource{}
+
+type UserDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Username  types.String `tfsdk:"username"`
+	Name      types.String `tfsdk:"name"`
+	Extension types.String `tfsdk:"extension"`
+	Email     types.String `tfsdk:"email"`
+	ChatName  types.String `tfsdk:"chat_name"`
+	Roles     types.Set    `tfsdk:"roles"`
+	Devices   types.Set    `tfsdk:"devices"`
+	Licenses  types.Set    `tfsdk:"licenses"`
+}
+
+// UserDataSource defines the data source implementation.
+type UserDataSource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the User by its username, extension or both.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique ID of the User.",
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The username to look up. Can be combined with `extension`.",
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("username"), path.MatchRoot("extension")),
+				},
+			},
+			"extension": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The extension number to look up. Can be combined with `username`.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The User display name.",
+			},
+			"email": schema.StringAttribute{
+				Computed:    true,
+				Description: "The User email.",
+			},
+			"chat_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The User name displayed externally.",
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The IDs of the Roles the User is a member of.",
+			},
+			"devices": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The IDs of the regular Devices attached to the User.",
+			},
+			"licenses": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The product names of the licenses granted to the User.",
+			},
+		},
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data UserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The search filters are case insensitive patterns, so page through
+	// all the hits and match the exact values
+	var found []*models.APIUser
+	for page := int32(1); ; page++ {
+		size := int32(100)
+		params := &users.UsersSearchUsersParams{
+			Context:   ctx,
+			Page:      &page,
+			Size:      &size,
+			Username:  data.Username.ValueStringPointer(),
+			Extension: data.Extension.ValueStringPointer(),
+		}
+
+		httpResp, err := d.client.Users.UsersSearchUsers(params)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				"An unexpected error occurred while attempting to read the Users. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		payload := httpResp.GetPayload()
+		for _, v := range payload.Items {
+			if userMatches(v, data.Username, data.Extension) {
+				found = append(found, v)
+			}
+		}
+
+		if !payload.Next || len(payload.Items) == 0 {
+			break
+		}
+	}
+
+	if len(found) != 1 {
+		resp.Diagnostics.AddError(
+			"Unable to Find User",
+			fmt.Sprintf("Expected exactly one User with username %s and extension %s, got: %d.", data.Username, data.Extension, len(found)),
+		)
+
+		return
+	}
+
+	// The search returns the short view, so read the User details
+	readResp, err := d.client.Users.UsersReadUser(&users.UsersReadUserParams{Context: ctx, ID: found[0].ID})
+	if err != nil || readResp.GetPayload().User == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			fmt.Sprintf("An unexpected error occurred while attempting to read the User %s. ", found[0].ID)+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				fmt.Sprintf("HTTP Error: %v", err),
+		)
+
+		return
+	}
+
+	user := userToTF(readResp.GetPayload().User, types.StringNull())
+	out := &UserDataSourceModel{
+		ID:        user.ID,
+		Username:  user.Username,
+		Name:      user.Name,
+		Extension: user.Extension,
+		Email:     user.Email,
+		ChatName:  user.ChatName,
+		Roles:     user.Roles,
+		Devices:   user.Devices,
+		Licenses:  user.Licenses,
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+// userMatches reports whether the User matches every set filter.
+func userMatches(in *models.APIUser, username, extension types.String) bool {
+	if !username.IsNull() && !strings.EqualFold(in.Username, username.ValueString()) {
+		return false
+	}
+
+	if !extension.IsNull() && in.Extension != extension.ValueString() {
+		return false
+	}
+
+	return true
+}
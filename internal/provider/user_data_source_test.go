@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+func TestUserMatches(t *testing.T) {
+	t.Parallel()
+
+	user := &models.APIUser{Username: "John", Extension: "101"}
+
+	testCases := map[string]struct {
+		username  types.String
+		extension types.String
+		expected  bool
+	}{
+		"username-case-insensitive": {
+			username:  types.StringValue("john"),
+			extension: types.StringNull(),
+			expected:  true,
+		},
+		"username-pattern": {
+			username:  types.StringValue("jo%"),
+			extension: types.StringNull(),
+			expected:  false,
+		},
+		"extension": {
+			username:  types.StringNull(),
+			extension: types.StringValue("101"),
+			expected:  true,
+		},
+		"both-match": {
+			username:  types.StringValue("john"),
+			extension: types.StringValue("101"),
+			expected:  true,
+		},
+		"only-username-matches": {
+			username:  types.StringValue("john"),
+			extension: types.StringValue("102"),
+			expected:  false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := userMatches(user, tc.username, tc.extension); got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/users"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+
+type UserResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+	Name      types.String `tfsdk:"name"`
+	Extension types.String `tfsdk:"extension"`
+	Email     types.String `tfsdk:"email"`
+	ChatName  types.String `tfsdk:"chat_name"`
+	Roles     types.Set    `tfsdk:"roles"`
+	Devices   types.Set    `tfsdk:"devices"`
+	Licenses  types.Set    `tfsdk:"licenses"`
+	Profile   types.Map    `tfsdk:"profile"`
+}
+
+// UserResource defines the resource implementation.
+type UserResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The User principal resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the User. Never changes.",
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The alphanumeric username (login). Unique within the domain.",
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "The User password. The password is never read back from the API, " +
+					"so the changes made outside of Terraform are not detected.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The User display name. Used as the Caller-ID-Name.",
+			},
+			"extension": schema.StringAttribute{
+				Optional:    true,
+				Description: "The User extension number. Used as the Caller-ID-Number.",
+			},
+			"email": schema.StringAttribute{
+				Optional:    true,
+				Description: "The User email.",
+			},
+			"chat_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The User name displayed externally, e.g. to the clients in chats.",
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The IDs of the Roles the User is a member of.",
+			},
+			"devices": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The IDs of the regular Devices attached to the User.",
+			},
+			"licenses": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The product names of the licenses granted to the User, e.g. `CALL_CENTER`.",
+			},
+			"profile": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The User profile variables assigned to the User as an environment unit.",
+			},
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := userFromTF(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.APICreateUserRequest{
+		User:            user,
+		UserPassword:    data.Password.ValueString(),
+		ConfirmPassword: data.Password.ValueString(),
+	}
+
+	httpResp, err := r.client.Users.UsersCreateUserWithParams(&users.UsersCreateUserParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) || httpResp.GetPayload().User == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, userToTF(httpResp.GetPayload().User, data.Password))...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &users.UsersReadUserParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.Users.UsersReadUser(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Treat the deleted (recycled) User as gone
+	user := httpResp.GetPayload().User
+	if user == nil || (user.DeletedAt != "" && user.DeletedAt != "0") {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, userToTF(user, data.Password))...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := userFromTF(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := &models.UsersUpdateUserParamsBody{
+		Username:  user.Username,
+		Name:      user.Name,
+		Extension: user.Extension,
+		Email:     user.Email,
+		ChatName:  user.ChatName,
+		Roles:     user.Roles,
+		Devices:   user.Devices,
+		License:   user.License,
+		Profile:   user.Profile,
+	}
+
+	// Send the password only when it is changed
+	if !plan.Password.Equal(state.Password) {
+		body.Password = plan.Password.ValueString()
+	}
+
+	params := &users.UsersUpdateUserParams{
+		Context: ctx,
+		UserID:  state.ID.ValueString(),
+		User:    body,
+	}
+
+	httpResp, err := r.client.Users.UsersUpdateUser(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, userToTF(httpResp.GetPayload(), plan.Password))...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &users.UsersDeleteUsersParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.Users.UsersDeleteUsers(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func userFromTF(ctx context.Context, data *UserResourceModel) (*models.APIUser, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	out := &models.APIUser{
+		Username:  data.Username.ValueString(),
+		Name:      data.Name.ValueString(),
+		Extension: data.Extension.ValueString(),
+		Email:     data.Email.ValueString(),
+		ChatName:  data.ChatName.ValueString(),
+		Roles:     make([]*models.APIObjectID, 0),
+		Devices:   make([]*models.APIObjectID, 0),
+		License:   make([]*models.APILicenseUser, 0),
+	}
+
+	var roles, devices, licenses []string
+	if !data.Roles.IsNull() {
+		diags.Append(data.Roles.ElementsAs(ctx, &roles, false)...)
+	}
+
+	if !data.Devices.IsNull() {
+		diags.Append(data.Devices.ElementsAs(ctx, &devices, false)...)
+	}
+
+	if !data.Licenses.IsNull() {
+		diags.Append(data.Licenses.ElementsAs(ctx, &licenses, false)...)
+	}
+
+	if !data.Profile.IsNull() {
+		diags.Append(data.Profile.ElementsAs(ctx, &out.Profile, false)...)
+	}
+
+	for _, v := range roles {
+		out.Roles = append(out.Roles, &models.APIObjectID{ID: v})
+	}
+
+	for _, v := range devices {
+		out.Devices = append(out.Devices, &models.APIObjectID{ID: v})
+	}
+
+	for _, v := range licenses {
+		out.License = append(out.License, &models.APILicenseUser{Prod: v})
+	}
+
+	return out, diags
+}
+
+func userToTF(in *models.APIUser, password types.String) *UserResourceModel {
+	out := &UserResourceModel{
+		ID:        types.StringValue(in.ID),
+		Username:  types.StringValue(in.Username),
+		Password:  password,
+		Name:      stringOrNull(in.Name),
+		Extension: stringOrNull(in.Extension),
+		Email:     stringOrNull(in.Email),
+		ChatName:  stringOrNull(in.ChatName),
+		Roles:     types.SetNull(types.StringType),
+		Devices:   types.SetNull(types.StringType),
+		Licenses:  types.SetNull(types.StringType),
+		Profile:   stringMapToTF(in.Profile),
+	}
+
+	if len(in.Roles) != 0 {
+		roles := make([]string, 0, len(in.Roles))
+		for _, v := range in.Roles {
+			roles = append(roles, v.ID)
+		}
+
+		out.Roles = stringsToSet(roles)
+	}
+
+	if len(in.Devices) != 0 {
+		devices := make([]string, 0, len(in.Devices))
+		for _, v := range in.Devices {
+			devices = append(devices, v.ID)
+		}
+
+		out.Devices = stringsToSet(devices)
+	}
+
+	if len(in.License) != 0 {
+		licenses := make([]string, 0, len(in.License))
+		for _, v := range in.License {
+			licenses = append(licenses, v.Prod)
+		}
+
+		out.Licenses = stringsToSet(licenses)
+	}
+
+	return out
+}
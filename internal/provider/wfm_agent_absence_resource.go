@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/agent_absence_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// wfmAbsenceTypes are the supported agent absence types.
+var wfmAbsenceTypes = []string{
+	string(models.WfmAgentAbsenceTypeAGENTABSENCETYPEDAYOFF),
+	string(models.WfmAgentAbsenceTypeAGENTABSENCETYPEVACATION),
+	string(models.WfmAgentAbsenceTypeAGENTABSENCETYPESICKDAY),
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WFMAgentAbsenceResource{}
+var _ resource.ResourceWithImportState = &WFMAgentAbsenceResource{}
+
+type WFMAgentAbsenceResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	AgentID  types.String `tfsdk:"agent_id"`
+	AbsentAt types.String `tfsdk:"absent_at"`
+	Type     types.String `tfsdk:"type"`
+}
+
+// WFMAgentAbsenceResource defines the resource implementation.
+type WFMAgentAbsenceResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewWFMAgentAbsenceResource() resource.Resource {
+	return &WFMAgentAbsenceResource{}
+}
+
+func (r *WFMAgentAbsenceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wfm_agent_absence"
+}
+
+func (r *WFMAgentAbsenceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Workforce Management Agent Absence. Records the day the agent is absent from work.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Agent Absence. Never changes.",
+			},
+			"agent_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Description:   "The ID of the absent Agent. Changing this forces a new resource to be created.",
+			},
+			"absent_at": schema.StringAttribute{
+				Required:    true,
+				Description: "The day of the absence. The timestamp in milliseconds since Unix epoch.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(millisecondsRegexp, "must be a timestamp in milliseconds"),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The absence type, e.g. `AGENT_ABSENCE_TYPE_VACATION`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(wfmAbsenceTypes...),
+				},
+			},
+		},
+	}
+}
+
+func (r *WFMAgentAbsenceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WFMAgentAbsenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data WFMAgentAbsenceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	absenceType := models.WfmAgentAbsenceType(data.Type.ValueString())
+	params := &agent_absence_service.AgentAbsenceServiceCreateAgentAbsenceParams{
+		Context:     ctx,
+		ItemAgentID: data.AgentID.ValueString(),
+		Body: &models.AgentAbsenceServiceCreateAgentAbsenceParamsBody{
+			Item: &models.AgentAbsenceServiceCreateAgentAbsenceParamsBodyItem{
+				Absence: &models.WfmAbsence{
+					AbsentAt: data.AbsentAt.ValueString(),
+					TypeID:   &absenceType,
+				},
+			},
+		},
+	}
+
+	httpResp, err := r.client.AgentAbsenceService.AgentAbsenceServiceCreateAgentAbsenceWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	item := httpResp.GetPayload().Item
+	if item == nil || item.Absence == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"The API response has no item.",
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, wfmAgentAbsenceToTF(item.Absence, data.AgentID))...)
+}
+
+func (r *WFMAgentAbsenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data WFMAgentAbsenceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &agent_absence_service.AgentAbsenceServiceReadAgentAbsencesParams{
+		Context: ctx,
+		AgentID: data.AgentID.ValueString(),
+	}
+
+	httpResp, err := r.client.AgentAbsenceService.AgentAbsenceServiceReadAgentAbsences(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// The API has no single absence read, so look it up within the agent absences
+	if item := httpResp.GetPayload().Item; item != nil {
+		for _, v := range item.Absences {
+			if v.ID == data.ID.ValueString() {
+				// Save updated data into Terraform state
+				resp.Diagnostics.Append(resp.State.Set(ctx, wfmAgentAbsenceToTF(v, data.AgentID))...)
+
+				return
+			}
+		}
+	}
+
+	// The absence is gone, recreate it
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *WFMAgentAbsenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state WFMAgentAbsenceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	absenceType := models.WfmAgentAbsenceType(plan.Type.ValueString())
+	params := &agent_absence_service.AgentAbsenceServiceUpdateAgentAbsenceParams{
+		Context:       ctx,
+		ItemAgentID:   state.AgentID.ValueString(),
+		ItemAbsenceID: state.ID.ValueString(),
+		Body: &models.AgentAbsenceServiceUpdateAgentAbsenceParamsBody{
+			Item: &models.AgentAbsenceServiceUpdateAgentAbsenceParamsBodyItem{
+				Absence: &models.AgentAbsenceServiceUpdateAgentAbsenceParamsBodyItemAbsence{
+					AbsentAt: plan.AbsentAt.ValueString(),
+					TypeID:   &absenceType,
+				},
+			},
+		},
+	}
+
+	httpResp, err := r.client.AgentAbsenceService.AgentAbsenceServiceUpdateAgentAbsence(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	item := httpResp.GetPayload().Item
+	if item == nil || item.Absence == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"The API response has no item.",
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, wfmAgentAbsenceToTF(item.Absence, state.AgentID))...)
+}
+
+func (r *WFMAgentAbsenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data WFMAgentAbsenceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &agent_absence_service.AgentAbsenceServiceDeleteAgentAbsenceParams{
+		Context: ctx,
+		AgentID: data.AgentID.ValueString(),
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.AgentAbsenceService.AgentAbsenceServiceDeleteAgentAbsenceWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *WFMAgentAbsenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateCompositeID(ctx, req, resp, "agent_id", "id")
+}
+
+func wfmAgentAbsenceToTF(in *models.WfmAbsence, agentID types.String) *WFMAgentAbsenceResourceModel {
+	out := &WFMAgentAbsenceResourceModel{
+		ID:       types.StringValue(in.ID),
+		AgentID:  agentID,
+		AbsentAt: millisecondsToTF(in.AbsentAt),
+		Type:     types.StringNull(),
+	}
+
+	if in.TypeID != nil {
+		out.Type = types.StringValue(string(*in.TypeID))
+	}
+
+	return out
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/forecast_calculation_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WFMForecastCalculationDataSource{}
+
+type WFMForecastCalculationDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Procedure   types.String `tfsdk:"procedure"`
+	Args        types.List   `tfsdk:"args"`
+}
+
+// WFMForecastCalculationDataSource defines the data source implementation.
+type WFMForecastCalculationDataSource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewWFMForecastCalculationDataSource() datasource.DataSource {
+	return &WFMForecastCalculationDataSource{}
+}
+
+func (d *WFMForecastCalculationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wfm_forecast_calculation"
+}
+
+func (d *WFMForecastCalculationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up the Workforce Management Forecast Calculation by its name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique ID of the Forecast Calculation.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Forecast Calculation name to look up.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "Short description of the Forecast Calculation.",
+			},
+			"procedure": schema.StringAttribute{
+				Computed:    true,
+				Description: "The database procedure calculating the forecast.",
+			},
+			"args": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The names of the procedure arguments.",
+			},
+		},
+	}
+}
+
+func (d *WFMForecastCalculationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WFMForecastCalculationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data WFMForecastCalculationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The search filters by a substring of the name,
+	// so page through the results and match exactly.
+	var found []*models.WfmForecastCalculation
+	for page := int32(1); ; page++ {
+		size := int32(100)
+		params := &forecast_calculation_service.ForecastCalculationServiceSearchForecastCalculationParams{
+			Context: ctx,
+			Page:    &page,
+			Size:    &size,
+			Q:       data.Name.ValueStringPointer(),
+		}
+
+		httpResp, err := d.client.ForecastCalculationService.ForecastCalculationServiceSearchForecastCalculation(params)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Data Source",
+				"An unexpected error occurred while attempting to read the Forecast Calculations. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"HTTP Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		payload := httpResp.GetPayload()
+		for _, v := range payload.Items {
+			if v.Name == data.Name.ValueString() {
+				found = append(found, v)
+			}
+		}
+
+		if !payload.Next || len(payload.Items) == 0 {
+			break
+		}
+	}
+
+	if len(found) != 1 {
+		resp.Diagnostics.AddError(
+			"Unable to Find Forecast Calculation",
+			fmt.Sprintf("Expected exactly one Forecast Calculation with name %s, got: %d.", data.Name, len(found)),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &WFMForecastCalculationDataSourceModel{
+		ID:          types.StringValue(found[0].ID),
+		Name:        types.StringValue(found[0].Name),
+		Description: stringOrNull(found[0].Description),
+		Procedure:   types.StringValue(found[0].Procedure),
+		Args:        stringsToList(found[0].Args),
+	})...)
+}
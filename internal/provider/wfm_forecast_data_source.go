@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/forecast_calculation_service"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WFMForecastDataSource{}
+
+type WFMForecastDataSourceModel struct {
+	CalculationID types.String `tfsdk:"calculation_id"`
+	TeamID        types.String `tfsdk:"team_id"`
+	From          types.String `tfsdk:"from"`
+	To            types.String `tfsdk:"to"`
+	Forecast      types.List   `tfsdk:"forecast"`
+}
+
+// WFMForecastDataSource defines the data source implementation.
+type WFMForecastDataSource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewWFMForecastDataSource() datasource.DataSource {
+	return &WFMForecastDataSource{}
+}
+
+func (d *WFMForecastDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wfm_forecast"
+}
+
+func (d *WFMForecastDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Executes the Workforce Management Forecast Calculation for the Team and returns the forecast of the required agents.",
+		Attributes: map[string]schema.Attribute{
+			"calculation_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Forecast Calculation, see `webitel_wfm_forecast_calculation`.",
+			},
+			"team_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Team to calculate the forecast for.",
+			},
+			"from": schema.StringAttribute{
+				Required:    true,
+				Description: "The start of the forecast period. The timestamp in milliseconds since Unix epoch.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(millisecondsRegexp, "must be a timestamp in milliseconds"),
+				},
+			},
+			"to": schema.StringAttribute{
+				Required:    true,
+				Description: "The end of the forecast period. The timestamp in milliseconds since Unix epoch.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(millisecondsRegexp, "must be a timestamp in milliseconds"),
+				},
+			},
+			"forecast": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The forecast items ordered as returned by the calculation.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							Computed:    true,
+							Description: "The forecast time. The timestamp in milliseconds since Unix epoch.",
+						},
+						"agents": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The forecast number of required agents.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WFMForecastDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WFMForecastDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Read Terraform configuration data into the model
+	var data WFMForecastDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &forecast_calculation_service.ForecastCalculationServiceExecuteForecastCalculationParams{
+		Context:          ctx,
+		ID:               data.CalculationID.ValueString(),
+		TeamID:           data.TeamID.ValueStringPointer(),
+		ForecastDataFrom: data.From.ValueStringPointer(),
+		ForecastDataTo:   data.To.ValueStringPointer(),
+	}
+
+	httpResp, err := d.client.ForecastCalculationService.ForecastCalculationServiceExecuteForecastCalculation(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Source",
+			"An unexpected error occurred while attempting to execute the Forecast Calculation. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	elements := []attr.Value{}
+	for _, v := range httpResp.GetPayload().Items {
+		var agents float64
+		if v.Agents != "" {
+			agents, err = strconv.ParseFloat(v.Agents, 64)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to parse forecast agents", err.Error())
+
+				return
+			}
+		}
+
+		elements = append(elements, types.ObjectValueMust(wfmForecastSchema().AttrTypes, map[string]attr.Value{
+			"timestamp": types.StringValue(v.Timestamp),
+			"agents":    types.Float64Value(agents),
+		}))
+	}
+
+	data.Forecast = types.ListValueMust(wfmForecastSchema(), elements)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func wfmForecastSchema() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"timestamp": types.StringType,
+			"agents":    types.Float64Type,
+		},
+	}
+}
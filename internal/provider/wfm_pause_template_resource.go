@@ -0,0 +1,396 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/pause_template_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WFMPauseTemplateResource{}
+var _ resource.ResourceWithImportState = &WFMPauseTemplateResource{}
+
+type WFMPauseTemplateResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Causes      types.List   `tfsdk:"causes"`
+}
+
+type WFMPauseTemplateCause struct {
+	CauseID  types.String `tfsdk:"cause_id"`
+	Duration types.Int64  `tfsdk:"duration"`
+}
+
+// WFMPauseTemplateResource defines the resource implementation.
+type WFMPauseTemplateResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewWFMPauseTemplateResource() resource.Resource {
+	return &WFMPauseTemplateResource{}
+}
+
+func (r *WFMPauseTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wfm_pause_template"
+}
+
+func wfmPauseTemplateCauseSchema() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"cause_id": types.StringType,
+			"duration": types.Int64Type,
+		},
+	}
+}
+
+func (r *WFMPauseTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Workforce Management Pause Template. Defines the agent pauses planned within the shift.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Pause Template. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Pause Template name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Pause Template.",
+			},
+			"causes": schema.ListNestedAttribute{
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cause_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the Pause Cause, see `webitel_pause_cause`.",
+						},
+						"duration": schema.Int64Attribute{
+							Required:    true,
+							Description: "The pause duration in minutes.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+				Description: "The pauses planned within the shift.",
+			},
+		},
+	}
+}
+
+func (r *WFMPauseTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WFMPauseTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data WFMPauseTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	causes, diags := wfmPauseTemplateCauses(ctx, data.Causes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.WfmCreatePauseTemplateRequest{
+		Item: &models.WfmPauseTemplate{
+			Name:        data.Name.ValueString(),
+			Description: data.Description.ValueString(),
+			Causes:      causes,
+		},
+	}
+
+	httpResp, err := r.client.PauseTemplateService.PauseTemplateServiceCreatePauseTemplateWithParams(&pause_template_service.PauseTemplateServiceCreatePauseTemplateParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	item := httpResp.GetPayload().Item
+	if item == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"The API response has no item.",
+		)
+
+		return
+	}
+
+	out, diags := wfmPauseTemplateToTF(item)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *WFMPauseTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data WFMPauseTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &pause_template_service.PauseTemplateServiceReadPauseTemplateParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.PauseTemplateService.PauseTemplateServiceReadPauseTemplate(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	item := httpResp.GetPayload().Item
+	if item == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	out, diags := wfmPauseTemplateToTF(item)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *WFMPauseTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state WFMPauseTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	causes, diags := wfmPauseTemplateCauses(ctx, plan.Causes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &pause_template_service.PauseTemplateServiceUpdatePauseTemplateParams{
+		Context: ctx,
+		ItemID:  state.ID.ValueString(),
+		Body: &models.PauseTemplateServiceUpdatePauseTemplateParamsBody{
+			Item: &models.PauseTemplateServiceUpdatePauseTemplateParamsBodyItem{
+				Name:        plan.Name.ValueString(),
+				Description: plan.Description.ValueString(),
+				Causes:      causes,
+			},
+		},
+	}
+
+	httpResp, err := r.client.PauseTemplateService.PauseTemplateServiceUpdatePauseTemplateWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	item := httpResp.GetPayload().Item
+	if item == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"The API response has no item.",
+		)
+
+		return
+	}
+
+	out, diags := wfmPauseTemplateToTF(item)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, out)...)
+}
+
+func (r *WFMPauseTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data WFMPauseTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &pause_template_service.PauseTemplateServiceDeletePauseTemplateParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.PauseTemplateService.PauseTemplateServiceDeletePauseTemplateWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *WFMPauseTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func wfmPauseTemplateCauses(ctx context.Context, l types.List) ([]*models.WfmPauseTemplateCause, diag.Diagnostics) {
+	out := make([]*models.WfmPauseTemplateCause, 0)
+	if l.IsNull() || l.IsUnknown() {
+		return out, nil
+	}
+
+	var causes []WFMPauseTemplateCause
+	diags := l.ElementsAs(ctx, &causes, false)
+	for _, v := range causes {
+		out = append(out, &models.WfmPauseTemplateCause{
+			Cause:    &models.WfmLookupEntity{ID: v.CauseID.ValueString()},
+			Duration: strconv.FormatInt(v.Duration.ValueInt64(), 10),
+		})
+	}
+
+	return out, diags
+}
+
+func wfmPauseTemplateToTF(in *models.WfmPauseTemplate) (*WFMPauseTemplateResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	causes := make([]attr.Value, 0, len(in.Causes))
+	for _, v := range in.Causes {
+		var duration int64
+		if v.Duration != "" {
+			var err error
+			duration, err = strconv.ParseInt(v.Duration, 10, 64)
+			if err != nil {
+				diags.AddError("Unable to parse pause duration", err.Error())
+
+				return nil, diags
+			}
+		}
+
+		causes = append(causes, types.ObjectValueMust(wfmPauseTemplateCauseSchema().AttributeTypes(), map[string]attr.Value{
+			"cause_id": wfmLookupToTF(v.Cause),
+			"duration": types.Int64Value(duration),
+		}))
+	}
+
+	return &WFMPauseTemplateResourceModel{
+		ID:          types.StringValue(in.ID),
+		Name:        types.StringValue(in.Name),
+		Description: stringOrNull(in.Description),
+		Causes:      types.ListValueMust(wfmPauseTemplateCauseSchema(), causes),
+	}, diags
+}
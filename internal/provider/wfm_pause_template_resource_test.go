@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+func TestWFMPauseTemplateToTF(t *testing.T) {
+	t.Parallel()
+
+	out, diags := wfmPauseTemplateToTF(&models.WfmPauseTemplate{
+		ID:   "1",
+		Name: "Default pauses",
+		Causes: []*models.WfmPauseTemplateCause{
+			{Cause: &models.WfmLookupEntity{ID: "2", Name: "Lunch"}, Duration: "60"},
+			{Cause: &models.WfmLookupEntity{ID: "3", Name: "Break"}},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var causes []WFMPauseTemplateCause
+	if diags := out.Causes.ElementsAs(context.Background(), &causes, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(causes) != 2 {
+		t.Fatalf("expected 2 causes, got %d", len(causes))
+	}
+
+	// The zero duration is omitted by the API
+	if causes[0].CauseID.ValueString() != "2" || causes[0].Duration.ValueInt64() != 60 ||
+		causes[1].CauseID.ValueString() != "3" || causes[1].Duration.ValueInt64() != 0 {
+		t.Errorf("unexpected causes: %v", causes)
+	}
+
+	if !out.Description.IsNull() {
+		t.Errorf("expected null description, got %s", out.Description)
+	}
+
+	_, diags = wfmPauseTemplateToTF(&models.WfmPauseTemplate{
+		ID:     "1",
+		Causes: []*models.WfmPauseTemplateCause{{Duration: "1h"}},
+	})
+	if !diags.HasError() {
+		t.Error("expected an error for the malformed duration")
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/shift_template_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WFMShiftTemplateResource{}
+var _ resource.ResourceWithImportState = &WFMShiftTemplateResource{}
+
+type WFMShiftTemplateResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Times       types.List   `tfsdk:"times"`
+}
+
+type WFMShiftTemplateTime struct {
+	Start types.Int64 `tfsdk:"start"`
+	End   types.Int64 `tfsdk:"end"`
+}
+
+// WFMShiftTemplateResource defines the resource implementation.
+type WFMShiftTemplateResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewWFMShiftTemplateResource() resource.Resource {
+	return &WFMShiftTemplateResource{}
+}
+
+func (r *WFMShiftTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wfm_shift_template"
+}
+
+func wfmShiftTemplateTimeSchema() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"start": types.Int64Type,
+			"end":   types.Int64Type,
+		},
+	}
+}
+
+func (r *WFMShiftTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Workforce Management Shift Template. Defines the working time ranges of the agent shift.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Shift Template. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Shift Template name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Shift Template.",
+			},
+			"times": schema.ListNestedAttribute{
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start": schema.Int64Attribute{
+							Required:    true,
+							Description: "The range start in minutes since midnight.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 1440),
+							},
+						},
+						"end": schema.Int64Attribute{
+							Required:    true,
+							Description: "The range end in minutes since midnight.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 1440),
+							},
+						},
+					},
+				},
+				Description: "The working time ranges of the shift.",
+			},
+		},
+	}
+}
+
+func (r *WFMShiftTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WFMShiftTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data WFMShiftTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	times, diags := wfmShiftTemplateTimes(ctx, data.Times)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.WfmCreateShiftTemplateRequest{
+		Item: &models.WfmShiftTemplate{
+			Name:        data.Name.ValueString(),
+			Description: data.Description.ValueString(),
+			Times:       times,
+		},
+	}
+
+	httpResp, err := r.client.ShiftTemplateService.ShiftTemplateServiceCreateShiftTemplateWithParams(&shift_template_service.ShiftTemplateServiceCreateShiftTemplateParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	item := httpResp.GetPayload().Item
+	if item == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"The API response has no item.",
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, wfmShiftTemplateToTF(item))...)
+}
+
+func (r *WFMShiftTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data WFMShiftTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &shift_template_service.ShiftTemplateServiceReadShiftTemplateParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.ShiftTemplateService.ShiftTemplateServiceReadShiftTemplate(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	item := httpResp.GetPayload().Item
+	if item == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, wfmShiftTemplateToTF(item))...)
+}
+
+func (r *WFMShiftTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state WFMShiftTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	times, diags := wfmShiftTemplateTimes(ctx, plan.Times)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &shift_template_service.ShiftTemplateServiceUpdateShiftTemplateParams{
+		Context: ctx,
+		ItemID:  state.ID.ValueString(),
+		Body: &models.ShiftTemplateServiceUpdateShiftTemplateParamsBody{
+			Item: &models.ShiftTemplateServiceUpdateShiftTemplateParamsBodyItem{
+				Name:        plan.Name.ValueString(),
+				Description: plan.Description.ValueString(),
+				Times:       times,
+			},
+		},
+	}
+
+	httpResp, err := r.client.ShiftTemplateService.ShiftTemplateServiceUpdateShiftTemplateWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	item := httpResp.GetPayload().Item
+	if item == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"The API response has no item.",
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, wfmShiftTemplateToTF(item))...)
+}
+
+func (r *WFMShiftTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data WFMShiftTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &shift_template_service.ShiftTemplateServiceDeleteShiftTemplateParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.ShiftTemplateService.ShiftTemplateServiceDeleteShiftTemplateWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *WFMShiftTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func wfmShiftTemplateTimes(ctx context.Context, l types.List) ([]*models.WfmShiftTemplateTime, diag.Diagnostics) {
+	out := make([]*models.WfmShiftTemplateTime, 0)
+	if l.IsNull() || l.IsUnknown() {
+		return out, nil
+	}
+
+	var times []WFMShiftTemplateTime
+	diags := l.ElementsAs(ctx, &times, false)
+	for _, v := range times {
+		out = append(out, &models.WfmShiftTemplateTime{
+			Start: int32(v.Start.ValueInt64()),
+			End:   int32(v.End.ValueInt64()),
+		})
+	}
+
+	return out, diags
+}
+
+func wfmShiftTemplateToTF(in *models.WfmShiftTemplate) *WFMShiftTemplateResourceModel {
+	times := make([]attr.Value, 0, len(in.Times))
+	for _, v := range in.Times {
+		times = append(times, types.ObjectValueMust(wfmShiftTemplateTimeSchema().AttributeTypes(), map[string]attr.Value{
+			"start": types.Int64Value(int64(v.Start)),
+			"end":   types.Int64Value(int64(v.End)),
+		}))
+	}
+
+	return &WFMShiftTemplateResourceModel{
+		ID:          types.StringValue(in.ID),
+		Name:        types.StringValue(in.Name),
+		Description: stringOrNull(in.Description),
+		Times:       types.ListValueMust(wfmShiftTemplateTimeSchema(), times),
+	}
+}
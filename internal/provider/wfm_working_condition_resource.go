@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	webitel "github.com/webitel/webitel-openapi-client-go/client"
+	"github.com/webitel/webitel-openapi-client-go/client/working_condition_service"
+	"github.com/webitel/webitel-openapi-client-go/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WFMWorkingConditionResource{}
+var _ resource.ResourceWithImportState = &WFMWorkingConditionResource{}
+
+type WFMWorkingConditionResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	WorkdayHours     types.Int64  `tfsdk:"workday_hours"`
+	WorkdaysPerMonth types.Int64  `tfsdk:"workdays_per_month"`
+	Vacation         types.Int64  `tfsdk:"vacation"`
+	SickLeaves       types.Int64  `tfsdk:"sick_leaves"`
+	DaysOff          types.Int64  `tfsdk:"days_off"`
+	PauseDuration    types.Int64  `tfsdk:"pause_duration"`
+	PauseTemplateID  types.String `tfsdk:"pause_template_id"`
+	ShiftTemplateID  types.String `tfsdk:"shift_template_id"`
+}
+
+// WFMWorkingConditionResource defines the resource implementation.
+type WFMWorkingConditionResource struct {
+	client *webitel.WebitelAPI
+}
+
+func NewWFMWorkingConditionResource() resource.Resource {
+	return &WFMWorkingConditionResource{}
+}
+
+func (r *WFMWorkingConditionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wfm_working_condition"
+}
+
+func (r *WFMWorkingConditionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The Workforce Management Working Condition. Defines the working time norms and templates applied to the agents scheduling.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "The unique ID of the Working Condition. Never changes.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Working Condition name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Short description of the Working Condition.",
+			},
+			"workday_hours": schema.Int64Attribute{
+				Required:    true,
+				Description: "The working hours per workday.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 24),
+				},
+			},
+			"workdays_per_month": schema.Int64Attribute{
+				Required:    true,
+				Description: "The number of workdays per month.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 31),
+				},
+			},
+			"vacation": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The number of vacation days per year.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"sick_leaves": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The number of sick leave days per year.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"days_off": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The number of days off per month.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 31),
+				},
+			},
+			"pause_duration": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "The total pause duration per workday in minutes.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"pause_template_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the Pause Template, see `webitel_wfm_pause_template`.",
+			},
+			"shift_template_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the Shift Template, see `webitel_wfm_shift_template`.",
+			},
+		},
+	}
+}
+
+func (r *WFMWorkingConditionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*webitel.WebitelAPI)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *webitel.WebitelAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WFMWorkingConditionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data WFMWorkingConditionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &models.WfmCreateWorkingConditionRequest{
+		Item: &models.WfmWorkingCondition{
+			Name:             data.Name.ValueString(),
+			Description:      data.Description.ValueString(),
+			WorkdayHours:     int32(data.WorkdayHours.ValueInt64()),
+			WorkdaysPerMonth: int32(data.WorkdaysPerMonth.ValueInt64()),
+			Vacation:         int32(data.Vacation.ValueInt64()),
+			SickLeaves:       int32(data.SickLeaves.ValueInt64()),
+			DaysOff:          int32(data.DaysOff.ValueInt64()),
+			PauseDuration:    int32(data.PauseDuration.ValueInt64()),
+			PauseTemplate:    wfmLookupOrNil(data.PauseTemplateID),
+			ShiftTemplate:    wfmLookupOrNil(data.ShiftTemplateID),
+		},
+	}
+
+	httpResp, err := r.client.WorkingConditionService.WorkingConditionServiceCreateWorkingConditionWithParams(&working_condition_service.WorkingConditionServiceCreateWorkingConditionParams{Context: ctx, Body: input})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	item := httpResp.GetPayload().Item
+	if item == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"The API response has no item.",
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, wfmWorkingConditionToTF(item))...)
+}
+
+func (r *WFMWorkingConditionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var data WFMWorkingConditionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &working_condition_service.WorkingConditionServiceReadWorkingConditionParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	httpResp, err := r.client.WorkingConditionService.WorkingConditionServiceReadWorkingCondition(input)
+	if err != nil {
+		if isNotFound(err) {
+			// Treat HTTP 404 Not Found status as a signal to recreate resource
+			// and return early
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	item := httpResp.GetPayload().Item
+	if item == nil {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, wfmWorkingConditionToTF(item))...)
+}
+
+func (r *WFMWorkingConditionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan && state data into the model
+	var plan, state WFMWorkingConditionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &working_condition_service.WorkingConditionServiceUpdateWorkingConditionParams{
+		Context: ctx,
+		ItemID:  state.ID.ValueString(),
+		Body: &models.WorkingConditionServiceUpdateWorkingConditionParamsBody{
+			Item: &models.WorkingConditionServiceUpdateWorkingConditionParamsBodyItem{
+				Name:             plan.Name.ValueString(),
+				Description:      plan.Description.ValueString(),
+				WorkdayHours:     int32(plan.WorkdayHours.ValueInt64()),
+				WorkdaysPerMonth: int32(plan.WorkdaysPerMonth.ValueInt64()),
+				Vacation:         int32(plan.Vacation.ValueInt64()),
+				SickLeaves:       int32(plan.SickLeaves.ValueInt64()),
+				DaysOff:          int32(plan.DaysOff.ValueInt64()),
+				PauseDuration:    int32(plan.PauseDuration.ValueInt64()),
+				PauseTemplate:    wfmLookupOrNil(plan.PauseTemplateID),
+				ShiftTemplate:    wfmLookupOrNil(plan.ShiftTemplateID),
+			},
+		},
+	}
+
+	httpResp, err := r.client.WorkingConditionService.WorkingConditionServiceUpdateWorkingConditionWithParams(params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Return error if the HTTP status code is not 200 OK
+	if !httpResp.IsCode(http.StatusOK) {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Status: "+fmt.Sprintf("%d", httpResp.Code()),
+		)
+
+		return
+	}
+
+	item := httpResp.GetPayload().Item
+	if item == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"The API response has no item.",
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, wfmWorkingConditionToTF(item))...)
+}
+
+func (r *WFMWorkingConditionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data WFMWorkingConditionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &working_condition_service.WorkingConditionServiceDeleteWorkingConditionParams{
+		Context: ctx,
+		ID:      data.ID.ValueString(),
+	}
+
+	// Ignore HTTP 404 Not Found status as the resource is already gone
+	_, err := r.client.WorkingConditionService.WorkingConditionServiceDeleteWorkingConditionWithParams(input)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+}
+
+func (r *WFMWorkingConditionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func wfmWorkingConditionToTF(in *models.WfmWorkingCondition) *WFMWorkingConditionResourceModel {
+	return &WFMWorkingConditionResourceModel{
+		ID:               types.StringValue(in.ID),
+		Name:             types.StringValue(in.Name),
+		Description:      stringOrNull(in.Description),
+		WorkdayHours:     types.Int64Value(int64(in.WorkdayHours)),
+		WorkdaysPerMonth: types.Int64Value(int64(in.WorkdaysPerMonth)),
+		Vacation:         types.Int64Value(int64(in.Vacation)),
+		SickLeaves:       types.Int64Value(int64(in.SickLeaves)),
+		DaysOff:          types.Int64Value(int64(in.DaysOff)),
+		PauseDuration:    types.Int64Value(int64(in.PauseDuration)),
+		PauseTemplateID:  wfmLookupToTF(in.PauseTemplate),
+		ShiftTemplateID:  wfmLookupToTF(in.ShiftTemplate),
+	}
+}